@@ -686,6 +686,35 @@ class PBPacker:
 ############### USER DATA BEGIN ################
 
 
+enum RoundPhase {
+	NONE = 0,
+	LOBBY = 1,
+	RUNNING = 2,
+	ENDED = 3
+}
+
+enum ChatTarget {
+	GLOBAL = 0,
+	TEAM = 1,
+	ROOM = 2,
+	DIRECT = 3
+}
+
+enum PowerUpKind {
+	POWER_UP_NONE = 0,
+	POWER_UP_SPEED = 1,
+	POWER_UP_SHIELD = 2,
+	POWER_UP_MAGNET = 3,
+	POWER_UP_MASS_MULTIPLIER = 4
+}
+
+enum FriendshipState {
+	NONE = 0,
+	FRIENDS = 1,
+	REQUEST_SENT = 2,
+	REQUEST_RECEIVED = 3
+}
+
 class ChatMessage:
 	func _init():
 		var service
@@ -695,6 +724,21 @@ class ChatMessage:
 		service.field = __msg
 		data[__msg.tag] = service
 		
+		__target = PBField.new("target", PB_DATA_TYPE.ENUM, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM])
+		service = PBServiceField.new()
+		service.field = __target
+		data[__target.tag] = service
+		
+		__target_id = PBField.new("target_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __target_id
+		data[__target_id.tag] = service
+		
+		__system = PBField.new("system", PB_DATA_TYPE.BOOL, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL])
+		service = PBServiceField.new()
+		service.field = __system
+		data[__system.tag] = service
+		
 	var data = {}
 	
 	var __msg: PBField
@@ -710,6 +754,45 @@ class ChatMessage:
 	func set_msg(value : String) -> void:
 		__msg.value = value
 	
+	var __target: PBField
+	func has_target() -> bool:
+		if __target.value != null:
+			return true
+		return false
+	func get_target():
+		return __target.value
+	func clear_target() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__target.value = DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM]
+	func set_target(value) -> void:
+		__target.value = value
+	
+	var __target_id: PBField
+	func has_target_id() -> bool:
+		if __target_id.value != null:
+			return true
+		return false
+	func get_target_id() -> int:
+		return __target_id.value
+	func clear_target_id() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__target_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_target_id(value : int) -> void:
+		__target_id.value = value
+	
+	var __system: PBField
+	func has_system() -> bool:
+		if __system.value != null:
+			return true
+		return false
+	func get_system() -> bool:
+		return __system.value
+	func clear_system() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__system.value = DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL]
+	func set_system(value : bool) -> void:
+		__system.value = value
+	
 	func _to_string() -> String:
 		return PBPacker.message_to_string(data)
 		
@@ -795,6 +878,11 @@ class LoginRequestMessage:
 		service.field = __color
 		data[__color.tag] = service
 		
+		__region = PBField.new("region", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __region
+		data[__region.tag] = service
+		
 	var data = {}
 	
 	var __username: PBField
@@ -836,6 +924,19 @@ class LoginRequestMessage:
 	func set_color(value : int) -> void:
 		__color.value = value
 	
+	var __region: PBField
+	func has_region() -> bool:
+		if __region.value != null:
+			return true
+		return false
+	func get_region() -> String:
+		return __region.value
+	func clear_region() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__region.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_region(value : String) -> void:
+		__region.value = value
+	
 	func _to_string() -> String:
 		return PBPacker.message_to_string(data)
 		
@@ -871,6 +972,11 @@ class GuestLoginRequestMessage:
 		service.field = __color
 		data[__color.tag] = service
 		
+		__region = PBField.new("region", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __region
+		data[__region.tag] = service
+		
 	var data = {}
 	
 	var __username: PBField
@@ -899,6 +1005,19 @@ class GuestLoginRequestMessage:
 	func set_color(value : int) -> void:
 		__color.value = value
 	
+	var __region: PBField
+	func has_region() -> bool:
+		if __region.value != null:
+			return true
+		return false
+	func get_region() -> String:
+		return __region.value
+	func clear_region() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__region.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_region(value : String) -> void:
+		__region.value = value
+	
 	func _to_string() -> String:
 		return PBPacker.message_to_string(data)
 		
@@ -1099,6 +1218,16 @@ class PlayerMessage:
 		service.field = __color
 		data[__color.tag] = service
 		
+		__team = PBField.new("team", PB_DATA_TYPE.INT32, PB_RULE.OPTIONAL, 9, true, DEFAULT_VALUES_3[PB_DATA_TYPE.INT32])
+		service = PBServiceField.new()
+		service.field = __team
+		data[__team.tag] = service
+		
+		__skin_id = PBField.new("skin_id", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 10, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __skin_id
+		data[__skin_id.tag] = service
+		
 	var data = {}
 	
 	var __id: PBField
@@ -1205,6 +1334,32 @@ class PlayerMessage:
 	func set_color(value : int) -> void:
 		__color.value = value
 	
+	var __team: PBField
+	func has_team() -> bool:
+		if __team.value != null:
+			return true
+		return false
+	func get_team() -> int:
+		return __team.value
+	func clear_team() -> void:
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__team.value = DEFAULT_VALUES_3[PB_DATA_TYPE.INT32]
+	func set_team(value : int) -> void:
+		__team.value = value
+	
+	var __skin_id: PBField
+	func has_skin_id() -> bool:
+		if __skin_id.value != null:
+			return true
+		return false
+	func get_skin_id() -> int:
+		return __skin_id.value
+	func clear_skin_id() -> void:
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__skin_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_skin_id(value : int) -> void:
+		__skin_id.value = value
+	
 	func _to_string() -> String:
 		return PBPacker.message_to_string(data)
 		
@@ -1550,173 +1705,7537 @@ class DisconnectMessage:
 			return PB_ERR.PARSE_INCOMPLETE
 		return result
 	
-class Packet:
+class TeamScoreMessage:
 	func _init():
 		var service
 		
-		__sender_id = PBField.new("sender_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		__team = PBField.new("team", PB_DATA_TYPE.INT32, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.INT32])
 		service = PBServiceField.new()
-		service.field = __sender_id
-		data[__sender_id.tag] = service
+		service.field = __team
+		data[__team.tag] = service
 		
-		__chat = PBField.new("chat", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		__color = PBField.new("color", PB_DATA_TYPE.INT32, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.INT32])
 		service = PBServiceField.new()
-		service.field = __chat
-		service.func_ref = Callable(self, "new_chat")
-		data[__chat.tag] = service
+		service.field = __color
+		data[__color.tag] = service
 		
-		__id = PBField.new("id", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		__players = PBField.new("players", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
 		service = PBServiceField.new()
-		service.field = __id
-		service.func_ref = Callable(self, "new_id")
-		data[__id.tag] = service
+		service.field = __players
+		data[__players.tag] = service
 		
-		__login_request = PBField.new("login_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		__mass = PBField.new("mass", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
 		service = PBServiceField.new()
-		service.field = __login_request
-		service.func_ref = Callable(self, "new_login_request")
-		data[__login_request.tag] = service
+		service.field = __mass
+		data[__mass.tag] = service
 		
-		__guest_login_request = PBField.new("guest_login_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
-		service = PBServiceField.new()
-		service.field = __guest_login_request
-		service.func_ref = Callable(self, "new_guest_login_request")
-		data[__guest_login_request.tag] = service
+	var data = {}
+	
+	var __team: PBField
+	func has_team() -> bool:
+		if __team.value != null:
+			return true
+		return false
+	func get_team() -> int:
+		return __team.value
+	func clear_team() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__team.value = DEFAULT_VALUES_3[PB_DATA_TYPE.INT32]
+	func set_team(value : int) -> void:
+		__team.value = value
+	
+	var __color: PBField
+	func has_color() -> bool:
+		if __color.value != null:
+			return true
+		return false
+	func get_color() -> int:
+		return __color.value
+	func clear_color() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.INT32]
+	func set_color(value : int) -> void:
+		__color.value = value
+	
+	var __players: PBField
+	func has_players() -> bool:
+		if __players.value != null:
+			return true
+		return false
+	func get_players() -> int:
+		return __players.value
+	func clear_players() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__players.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_players(value : int) -> void:
+		__players.value = value
+	
+	var __mass: PBField
+	func has_mass() -> bool:
+		if __mass.value != null:
+			return true
+		return false
+	func get_mass() -> float:
+		return __mass.value
+	func clear_mass() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_mass(value : float) -> void:
+		__mass.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
 		
-		__register_request = PBField.new("register_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 6, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
-		service = PBServiceField.new()
-		service.field = __register_request
-		service.func_ref = Callable(self, "new_register_request")
-		data[__register_request.tag] = service
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
 		
-		__ok_response = PBField.new("ok_response", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 7, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
-		service = PBServiceField.new()
-		service.field = __ok_response
-		service.func_ref = Callable(self, "new_ok_response")
-		data[__ok_response.tag] = service
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class TeamScoreboardMessage:
+	func _init():
+		var service
 		
-		__deny_response = PBField.new("deny_response", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 8, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		var __scores_default: Array[TeamScoreMessage] = []
+		__scores = PBField.new("scores", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 1, true, __scores_default)
 		service = PBServiceField.new()
-		service.field = __deny_response
-		service.func_ref = Callable(self, "new_deny_response")
-		data[__deny_response.tag] = service
+		service.field = __scores
+		service.func_ref = Callable(self, "add_scores")
+		data[__scores.tag] = service
 		
-		__player = PBField.new("player", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 9, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
-		service = PBServiceField.new()
-		service.field = __player
-		service.func_ref = Callable(self, "new_player")
-		data[__player.tag] = service
+	var data = {}
+	
+	var __scores: PBField
+	func get_scores() -> Array[TeamScoreMessage]:
+		return __scores.value
+	func clear_scores() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__scores.value.clear()
+	func add_scores() -> TeamScoreMessage:
+		var element = TeamScoreMessage.new()
+		__scores.value.append(element)
+		return element
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
 		
-		__player_direction = PBField.new("player_direction", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 10, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
-		service = PBServiceField.new()
-		service.field = __player_direction
-		service.func_ref = Callable(self, "new_player_direction")
-		data[__player_direction.tag] = service
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
 		
-		__spore = PBField.new("spore", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 11, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class RoundStateMessage:
+	func _init():
+		var service
+		
+		__round = PBField.new("round", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
 		service = PBServiceField.new()
-		service.field = __spore
-		service.func_ref = Callable(self, "new_spore")
-		data[__spore.tag] = service
+		service.field = __round
+		data[__round.tag] = service
 		
-		__spore_consumed = PBField.new("spore_consumed", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 12, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		__phase = PBField.new("phase", PB_DATA_TYPE.ENUM, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM])
 		service = PBServiceField.new()
-		service.field = __spore_consumed
-		service.func_ref = Callable(self, "new_spore_consumed")
-		data[__spore_consumed.tag] = service
+		service.field = __phase
+		data[__phase.tag] = service
 		
-		__spores_batch = PBField.new("spores_batch", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 13, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		__time_remaining = PBField.new("time_remaining", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
 		service = PBServiceField.new()
-		service.field = __spores_batch
-		service.func_ref = Callable(self, "new_spores_batch")
-		data[__spores_batch.tag] = service
+		service.field = __time_remaining
+		data[__time_remaining.tag] = service
 		
-		__player_consumed = PBField.new("player_consumed", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 14, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		__winner_id = PBField.new("winner_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
 		service = PBServiceField.new()
-		service.field = __player_consumed
-		service.func_ref = Callable(self, "new_player_consumed")
-		data[__player_consumed.tag] = service
+		service.field = __winner_id
+		data[__winner_id.tag] = service
 		
-		__disconnect = PBField.new("disconnect", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 15, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		__winner_name = PBField.new("winner_name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
 		service = PBServiceField.new()
-		service.field = __disconnect
-		service.func_ref = Callable(self, "new_disconnect")
-		data[__disconnect.tag] = service
+		service.field = __winner_name
+		data[__winner_name.tag] = service
 		
 	var data = {}
 	
-	var __sender_id: PBField
-	func has_sender_id() -> bool:
-		if __sender_id.value != null:
+	var __round: PBField
+	func has_round() -> bool:
+		if __round.value != null:
 			return true
 		return false
-	func get_sender_id() -> int:
-		return __sender_id.value
-	func clear_sender_id() -> void:
+	func get_round() -> int:
+		return __round.value
+	func clear_round() -> void:
 		data[1].state = PB_SERVICE_STATE.UNFILLED
-		__sender_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
-	func set_sender_id(value : int) -> void:
-		__sender_id.value = value
+		__round.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_round(value : int) -> void:
+		__round.value = value
 	
-	var __chat: PBField
-	func has_chat() -> bool:
-		if __chat.value != null:
+	var __phase: PBField
+	func has_phase() -> bool:
+		if __phase.value != null:
 			return true
 		return false
-	func get_chat() -> ChatMessage:
-		return __chat.value
-	func clear_chat() -> void:
+	func get_phase():
+		return __phase.value
+	func clear_phase() -> void:
 		data[2].state = PB_SERVICE_STATE.UNFILLED
-		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_chat() -> ChatMessage:
-		data[2].state = PB_SERVICE_STATE.FILLED
-		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-		data[3].state = PB_SERVICE_STATE.UNFILLED
-		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-		data[4].state = PB_SERVICE_STATE.UNFILLED
-		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-		data[5].state = PB_SERVICE_STATE.UNFILLED
-		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-		data[6].state = PB_SERVICE_STATE.UNFILLED
-		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-		data[7].state = PB_SERVICE_STATE.UNFILLED
-		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-		data[8].state = PB_SERVICE_STATE.UNFILLED
-		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-		data[9].state = PB_SERVICE_STATE.UNFILLED
-		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-		data[10].state = PB_SERVICE_STATE.UNFILLED
-		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-		data[11].state = PB_SERVICE_STATE.UNFILLED
-		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-		data[12].state = PB_SERVICE_STATE.UNFILLED
-		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-		data[13].state = PB_SERVICE_STATE.UNFILLED
-		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-		data[14].state = PB_SERVICE_STATE.UNFILLED
-		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-		data[15].state = PB_SERVICE_STATE.UNFILLED
-		__chat.value = ChatMessage.new()
-		return __chat.value
+		__phase.value = DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM]
+	func set_phase(value) -> void:
+		__phase.value = value
 	
-	var __id: PBField
-	func has_id() -> bool:
-		if __id.value != null:
+	var __time_remaining: PBField
+	func has_time_remaining() -> bool:
+		if __time_remaining.value != null:
 			return true
 		return false
-	func get_id() -> IdMessage:
-		return __id.value
-	func clear_id() -> void:
+	func get_time_remaining() -> float:
+		return __time_remaining.value
+	func clear_time_remaining() -> void:
 		data[3].state = PB_SERVICE_STATE.UNFILLED
-		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_id() -> IdMessage:
-		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-		data[2].state = PB_SERVICE_STATE.UNFILLED
-		data[3].state = PB_SERVICE_STATE.FILLED
-		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		__time_remaining.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_time_remaining(value : float) -> void:
+		__time_remaining.value = value
+	
+	var __winner_id: PBField
+	func has_winner_id() -> bool:
+		if __winner_id.value != null:
+			return true
+		return false
+	func get_winner_id() -> int:
+		return __winner_id.value
+	func clear_winner_id() -> void:
 		data[4].state = PB_SERVICE_STATE.UNFILLED
-		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__winner_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_winner_id(value : int) -> void:
+		__winner_id.value = value
+	
+	var __winner_name: PBField
+	func has_winner_name() -> bool:
+		if __winner_name.value != null:
+			return true
+		return false
+	func get_winner_name() -> String:
+		return __winner_name.value
+	func clear_winner_name() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__winner_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_winner_name(value : String) -> void:
+		__winner_name.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class SafeZoneMessage:
+	func _init():
+		var service
+		
+		__x = PBField.new("x", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __x
+		data[__x.tag] = service
+		
+		__y = PBField.new("y", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __y
+		data[__y.tag] = service
+		
+		__radius = PBField.new("radius", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __radius
+		data[__radius.tag] = service
+		
+		__target_radius = PBField.new("target_radius", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __target_radius
+		data[__target_radius.tag] = service
+		
+	var data = {}
+	
+	var __x: PBField
+	func has_x() -> bool:
+		if __x.value != null:
+			return true
+		return false
+	func get_x() -> float:
+		return __x.value
+	func clear_x() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__x.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_x(value : float) -> void:
+		__x.value = value
+	
+	var __y: PBField
+	func has_y() -> bool:
+		if __y.value != null:
+			return true
+		return false
+	func get_y() -> float:
+		return __y.value
+	func clear_y() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__y.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_y(value : float) -> void:
+		__y.value = value
+	
+	var __radius: PBField
+	func has_radius() -> bool:
+		if __radius.value != null:
+			return true
+		return false
+	func get_radius() -> float:
+		return __radius.value
+	func clear_radius() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__radius.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_radius(value : float) -> void:
+		__radius.value = value
+	
+	var __target_radius: PBField
+	func has_target_radius() -> bool:
+		if __target_radius.value != null:
+			return true
+		return false
+	func get_target_radius() -> float:
+		return __target_radius.value
+	func clear_target_radius() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__target_radius.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_target_radius(value : float) -> void:
+		__target_radius.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class WorldResetMessage:
+	func _init():
+		var service
+		
+	var data = {}
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class PowerUpMessage:
+	func _init():
+		var service
+		
+		__id = PBField.new("id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __id
+		data[__id.tag] = service
+		
+		__x = PBField.new("x", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __x
+		data[__x.tag] = service
+		
+		__y = PBField.new("y", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __y
+		data[__y.tag] = service
+		
+		__radius = PBField.new("radius", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __radius
+		data[__radius.tag] = service
+		
+		__kind = PBField.new("kind", PB_DATA_TYPE.ENUM, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM])
+		service = PBServiceField.new()
+		service.field = __kind
+		data[__kind.tag] = service
+		
+	var data = {}
+	
+	var __id: PBField
+	func has_id() -> bool:
+		if __id.value != null:
+			return true
+		return false
+	func get_id() -> int:
+		return __id.value
+	func clear_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_id(value : int) -> void:
+		__id.value = value
+	
+	var __x: PBField
+	func has_x() -> bool:
+		if __x.value != null:
+			return true
+		return false
+	func get_x() -> float:
+		return __x.value
+	func clear_x() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__x.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_x(value : float) -> void:
+		__x.value = value
+	
+	var __y: PBField
+	func has_y() -> bool:
+		if __y.value != null:
+			return true
+		return false
+	func get_y() -> float:
+		return __y.value
+	func clear_y() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__y.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_y(value : float) -> void:
+		__y.value = value
+	
+	var __radius: PBField
+	func has_radius() -> bool:
+		if __radius.value != null:
+			return true
+		return false
+	func get_radius() -> float:
+		return __radius.value
+	func clear_radius() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__radius.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_radius(value : float) -> void:
+		__radius.value = value
+	
+	var __kind: PBField
+	func has_kind() -> bool:
+		if __kind.value != null:
+			return true
+		return false
+	func get_kind():
+		return __kind.value
+	func clear_kind() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__kind.value = DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM]
+	func set_kind(value) -> void:
+		__kind.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class PowerUpConsumedMessage:
+	func _init():
+		var service
+		
+		__power_up_id = PBField.new("power_up_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __power_up_id
+		data[__power_up_id.tag] = service
+		
+	var data = {}
+	
+	var __power_up_id: PBField
+	func has_power_up_id() -> bool:
+		if __power_up_id.value != null:
+			return true
+		return false
+	func get_power_up_id() -> int:
+		return __power_up_id.value
+	func clear_power_up_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_power_up_id(value : int) -> void:
+		__power_up_id.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ActiveEffectMessage:
+	func _init():
+		var service
+		
+		__kind = PBField.new("kind", PB_DATA_TYPE.ENUM, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM])
+		service = PBServiceField.new()
+		service.field = __kind
+		data[__kind.tag] = service
+		
+		__time_remaining = PBField.new("time_remaining", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __time_remaining
+		data[__time_remaining.tag] = service
+		
+	var data = {}
+	
+	var __kind: PBField
+	func has_kind() -> bool:
+		if __kind.value != null:
+			return true
+		return false
+	func get_kind():
+		return __kind.value
+	func clear_kind() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__kind.value = DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM]
+	func set_kind(value) -> void:
+		__kind.value = value
+	
+	var __time_remaining: PBField
+	func has_time_remaining() -> bool:
+		if __time_remaining.value != null:
+			return true
+		return false
+	func get_time_remaining() -> float:
+		return __time_remaining.value
+	func clear_time_remaining() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__time_remaining.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_time_remaining(value : float) -> void:
+		__time_remaining.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class PlayerEffectsMessage:
+	func _init():
+		var service
+		
+		__player_id = PBField.new("player_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __player_id
+		data[__player_id.tag] = service
+		
+		var __effects_default: Array[ActiveEffectMessage] = []
+		__effects = PBField.new("effects", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 2, true, __effects_default)
+		service = PBServiceField.new()
+		service.field = __effects
+		service.func_ref = Callable(self, "add_effects")
+		data[__effects.tag] = service
+		
+	var data = {}
+	
+	var __player_id: PBField
+	func has_player_id() -> bool:
+		if __player_id.value != null:
+			return true
+		return false
+	func get_player_id() -> int:
+		return __player_id.value
+	func clear_player_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__player_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_player_id(value : int) -> void:
+		__player_id.value = value
+	
+	var __effects: PBField
+	func get_effects() -> Array[ActiveEffectMessage]:
+		return __effects.value
+	func clear_effects() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__effects.value.clear()
+	func add_effects() -> ActiveEffectMessage:
+		var element = ActiveEffectMessage.new()
+		__effects.value.append(element)
+		return element
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class DeathMessage:
+	func _init():
+		var service
+		
+		__killer_id = PBField.new("killer_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __killer_id
+		data[__killer_id.tag] = service
+		
+		__killer_name = PBField.new("killer_name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __killer_name
+		data[__killer_name.tag] = service
+		
+		__final_mass = PBField.new("final_mass", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __final_mass
+		data[__final_mass.tag] = service
+		
+		__time_alive = PBField.new("time_alive", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __time_alive
+		data[__time_alive.tag] = service
+		
+		__rank = PBField.new("rank", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __rank
+		data[__rank.tag] = service
+		
+		__respawn_cooldown = PBField.new("respawn_cooldown", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 6, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __respawn_cooldown
+		data[__respawn_cooldown.tag] = service
+		
+	var data = {}
+	
+	var __killer_id: PBField
+	func has_killer_id() -> bool:
+		if __killer_id.value != null:
+			return true
+		return false
+	func get_killer_id() -> int:
+		return __killer_id.value
+	func clear_killer_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__killer_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_killer_id(value : int) -> void:
+		__killer_id.value = value
+	
+	var __killer_name: PBField
+	func has_killer_name() -> bool:
+		if __killer_name.value != null:
+			return true
+		return false
+	func get_killer_name() -> String:
+		return __killer_name.value
+	func clear_killer_name() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__killer_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_killer_name(value : String) -> void:
+		__killer_name.value = value
+	
+	var __final_mass: PBField
+	func has_final_mass() -> bool:
+		if __final_mass.value != null:
+			return true
+		return false
+	func get_final_mass() -> float:
+		return __final_mass.value
+	func clear_final_mass() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__final_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_final_mass(value : float) -> void:
+		__final_mass.value = value
+	
+	var __time_alive: PBField
+	func has_time_alive() -> bool:
+		if __time_alive.value != null:
+			return true
+		return false
+	func get_time_alive() -> float:
+		return __time_alive.value
+	func clear_time_alive() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__time_alive.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_time_alive(value : float) -> void:
+		__time_alive.value = value
+	
+	var __rank: PBField
+	func has_rank() -> bool:
+		if __rank.value != null:
+			return true
+		return false
+	func get_rank() -> int:
+		return __rank.value
+	func clear_rank() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_rank(value : int) -> void:
+		__rank.value = value
+	
+	var __respawn_cooldown: PBField
+	func has_respawn_cooldown() -> bool:
+		if __respawn_cooldown.value != null:
+			return true
+		return false
+	func get_respawn_cooldown() -> float:
+		return __respawn_cooldown.value
+	func clear_respawn_cooldown() -> void:
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_cooldown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_respawn_cooldown(value : float) -> void:
+		__respawn_cooldown.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class RespawnRequestMessage:
+	func _init():
+		var service
+		
+	var data = {}
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ReportMessageMessage:
+	func _init():
+		var service
+		
+		__player_id = PBField.new("player_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __player_id
+		data[__player_id.tag] = service
+		
+		__msg = PBField.new("msg", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __msg
+		data[__msg.tag] = service
+		
+		__reason = PBField.new("reason", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __reason
+		data[__reason.tag] = service
+		
+	var data = {}
+	
+	var __player_id: PBField
+	func has_player_id() -> bool:
+		if __player_id.value != null:
+			return true
+		return false
+	func get_player_id() -> int:
+		return __player_id.value
+	func clear_player_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__player_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_player_id(value : int) -> void:
+		__player_id.value = value
+	
+	var __msg: PBField
+	func has_msg() -> bool:
+		if __msg.value != null:
+			return true
+		return false
+	func get_msg() -> String:
+		return __msg.value
+	func clear_msg() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__msg.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_msg(value : String) -> void:
+		__msg.value = value
+	
+	var __reason: PBField
+	func has_reason() -> bool:
+		if __reason.value != null:
+			return true
+		return false
+	func get_reason() -> String:
+		return __reason.value
+	func clear_reason() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__reason.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_reason(value : String) -> void:
+		__reason.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ChatHistoryEntryMessage:
+	func _init():
+		var service
+		
+		__sender_id = PBField.new("sender_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __sender_id
+		data[__sender_id.tag] = service
+		
+		__sender_name = PBField.new("sender_name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __sender_name
+		data[__sender_name.tag] = service
+		
+		__msg = PBField.new("msg", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __msg
+		data[__msg.tag] = service
+		
+		__sent_at = PBField.new("sent_at", PB_DATA_TYPE.INT64, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.INT64])
+		service = PBServiceField.new()
+		service.field = __sent_at
+		data[__sent_at.tag] = service
+		
+	var data = {}
+	
+	var __sender_id: PBField
+	func has_sender_id() -> bool:
+		if __sender_id.value != null:
+			return true
+		return false
+	func get_sender_id() -> int:
+		return __sender_id.value
+	func clear_sender_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__sender_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_sender_id(value : int) -> void:
+		__sender_id.value = value
+	
+	var __sender_name: PBField
+	func has_sender_name() -> bool:
+		if __sender_name.value != null:
+			return true
+		return false
+	func get_sender_name() -> String:
+		return __sender_name.value
+	func clear_sender_name() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__sender_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_sender_name(value : String) -> void:
+		__sender_name.value = value
+	
+	var __msg: PBField
+	func has_msg() -> bool:
+		if __msg.value != null:
+			return true
+		return false
+	func get_msg() -> String:
+		return __msg.value
+	func clear_msg() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__msg.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_msg(value : String) -> void:
+		__msg.value = value
+	
+	var __sent_at: PBField
+	func has_sent_at() -> bool:
+		if __sent_at.value != null:
+			return true
+		return false
+	func get_sent_at() -> int:
+		return __sent_at.value
+	func clear_sent_at() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__sent_at.value = DEFAULT_VALUES_3[PB_DATA_TYPE.INT64]
+	func set_sent_at(value : int) -> void:
+		__sent_at.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ChatHistoryMessage:
+	func _init():
+		var service
+		
+		var __messages_default: Array[ChatHistoryEntryMessage] = []
+		__messages = PBField.new("messages", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 1, true, __messages_default)
+		service = PBServiceField.new()
+		service.field = __messages
+		service.func_ref = Callable(self, "add_messages")
+		data[__messages.tag] = service
+		
+	var data = {}
+	
+	var __messages: PBField
+	func get_messages() -> Array[ChatHistoryEntryMessage]:
+		return __messages.value
+	func clear_messages() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__messages.value.clear()
+	func add_messages() -> ChatHistoryEntryMessage:
+		var element = ChatHistoryEntryMessage.new()
+		__messages.value.append(element)
+		return element
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ChangePasswordRequestMessage:
+	func _init():
+		var service
+		
+		__username = PBField.new("username", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __username
+		data[__username.tag] = service
+		
+		__old_password = PBField.new("old_password", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __old_password
+		data[__old_password.tag] = service
+		
+		__new_password = PBField.new("new_password", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __new_password
+		data[__new_password.tag] = service
+		
+	var data = {}
+	
+	var __username: PBField
+	func has_username() -> bool:
+		if __username.value != null:
+			return true
+		return false
+	func get_username() -> String:
+		return __username.value
+	func clear_username() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__username.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_username(value : String) -> void:
+		__username.value = value
+	
+	var __old_password: PBField
+	func has_old_password() -> bool:
+		if __old_password.value != null:
+			return true
+		return false
+	func get_old_password() -> String:
+		return __old_password.value
+	func clear_old_password() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__old_password.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_old_password(value : String) -> void:
+		__old_password.value = value
+	
+	var __new_password: PBField
+	func has_new_password() -> bool:
+		if __new_password.value != null:
+			return true
+		return false
+	func get_new_password() -> String:
+		return __new_password.value
+	func clear_new_password() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__new_password.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_new_password(value : String) -> void:
+		__new_password.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ResetPasswordRequestMessage:
+	func _init():
+		var service
+		
+		__username = PBField.new("username", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __username
+		data[__username.tag] = service
+		
+		__reset_code = PBField.new("reset_code", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __reset_code
+		data[__reset_code.tag] = service
+		
+		__new_password = PBField.new("new_password", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __new_password
+		data[__new_password.tag] = service
+		
+	var data = {}
+	
+	var __username: PBField
+	func has_username() -> bool:
+		if __username.value != null:
+			return true
+		return false
+	func get_username() -> String:
+		return __username.value
+	func clear_username() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__username.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_username(value : String) -> void:
+		__username.value = value
+	
+	var __reset_code: PBField
+	func has_reset_code() -> bool:
+		if __reset_code.value != null:
+			return true
+		return false
+	func get_reset_code() -> String:
+		return __reset_code.value
+	func clear_reset_code() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__reset_code.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_reset_code(value : String) -> void:
+		__reset_code.value = value
+	
+	var __new_password: PBField
+	func has_new_password() -> bool:
+		if __new_password.value != null:
+			return true
+		return false
+	func get_new_password() -> String:
+		return __new_password.value
+	func clear_new_password() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__new_password.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_new_password(value : String) -> void:
+		__new_password.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class DeleteAccountRequestMessage:
+	func _init():
+		var service
+		
+		__username = PBField.new("username", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __username
+		data[__username.tag] = service
+		
+		__password = PBField.new("password", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __password
+		data[__password.tag] = service
+		
+	var data = {}
+	
+	var __username: PBField
+	func has_username() -> bool:
+		if __username.value != null:
+			return true
+		return false
+	func get_username() -> String:
+		return __username.value
+	func clear_username() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__username.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_username(value : String) -> void:
+		__username.value = value
+	
+	var __password: PBField
+	func has_password() -> bool:
+		if __password.value != null:
+			return true
+		return false
+	func get_password() -> String:
+		return __password.value
+	func clear_password() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__password.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_password(value : String) -> void:
+		__password.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ProfileMessage:
+	func _init():
+		var service
+		
+		__color = PBField.new("color", PB_DATA_TYPE.INT32, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.INT32])
+		service = PBServiceField.new()
+		service.field = __color
+		data[__color.tag] = service
+		
+		__display_name = PBField.new("display_name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __display_name
+		data[__display_name.tag] = service
+		
+		__skin_id = PBField.new("skin_id", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __skin_id
+		data[__skin_id.tag] = service
+		
+		__settings = PBField.new("settings", PB_DATA_TYPE.BYTES, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.BYTES])
+		service = PBServiceField.new()
+		service.field = __settings
+		data[__settings.tag] = service
+		
+	var data = {}
+	
+	var __color: PBField
+	func has_color() -> bool:
+		if __color.value != null:
+			return true
+		return false
+	func get_color() -> int:
+		return __color.value
+	func clear_color() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.INT32]
+	func set_color(value : int) -> void:
+		__color.value = value
+	
+	var __display_name: PBField
+	func has_display_name() -> bool:
+		if __display_name.value != null:
+			return true
+		return false
+	func get_display_name() -> String:
+		return __display_name.value
+	func clear_display_name() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__display_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_display_name(value : String) -> void:
+		__display_name.value = value
+	
+	var __skin_id: PBField
+	func has_skin_id() -> bool:
+		if __skin_id.value != null:
+			return true
+		return false
+	func get_skin_id() -> int:
+		return __skin_id.value
+	func clear_skin_id() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__skin_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_skin_id(value : int) -> void:
+		__skin_id.value = value
+	
+	var __settings: PBField
+	func has_settings() -> bool:
+		if __settings.value != null:
+			return true
+		return false
+	func get_settings() -> PackedByteArray:
+		return __settings.value
+	func clear_settings() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.BYTES]
+	func set_settings(value : PackedByteArray) -> void:
+		__settings.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class GetProfileRequestMessage:
+	func _init():
+		var service
+		
+	var data = {}
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class HistoryRequestMessage:
+	func _init():
+		var service
+		
+		__username = PBField.new("username", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __username
+		data[__username.tag] = service
+		
+		__password = PBField.new("password", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __password
+		data[__password.tag] = service
+		
+		__limit = PBField.new("limit", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __limit
+		data[__limit.tag] = service
+		
+	var data = {}
+	
+	var __username: PBField
+	func has_username() -> bool:
+		if __username.value != null:
+			return true
+		return false
+	func get_username() -> String:
+		return __username.value
+	func clear_username() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__username.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_username(value : String) -> void:
+		__username.value = value
+	
+	var __password: PBField
+	func has_password() -> bool:
+		if __password.value != null:
+			return true
+		return false
+	func get_password() -> String:
+		return __password.value
+	func clear_password() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__password.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_password(value : String) -> void:
+		__password.value = value
+	
+	var __limit: PBField
+	func has_limit() -> bool:
+		if __limit.value != null:
+			return true
+		return false
+	func get_limit() -> int:
+		return __limit.value
+	func clear_limit() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__limit.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_limit(value : int) -> void:
+		__limit.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class SessionMessage:
+	func _init():
+		var service
+		
+		__player_name = PBField.new("player_name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __player_name
+		data[__player_name.tag] = service
+		
+		__room = PBField.new("room", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __room
+		data[__room.tag] = service
+		
+		__started_at = PBField.new("started_at", PB_DATA_TYPE.INT64, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.INT64])
+		service = PBServiceField.new()
+		service.field = __started_at
+		data[__started_at.tag] = service
+		
+		__ended_at = PBField.new("ended_at", PB_DATA_TYPE.INT64, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.INT64])
+		service = PBServiceField.new()
+		service.field = __ended_at
+		data[__ended_at.tag] = service
+		
+		__end_cause = PBField.new("end_cause", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __end_cause
+		data[__end_cause.tag] = service
+		
+		__killer_name = PBField.new("killer_name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 6, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __killer_name
+		data[__killer_name.tag] = service
+		
+		__peak_mass = PBField.new("peak_mass", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 7, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __peak_mass
+		data[__peak_mass.tag] = service
+		
+		__spores_eaten = PBField.new("spores_eaten", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 8, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __spores_eaten
+		data[__spores_eaten.tag] = service
+		
+		__players_eaten = PBField.new("players_eaten", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 9, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __players_eaten
+		data[__players_eaten.tag] = service
+		
+	var data = {}
+	
+	var __player_name: PBField
+	func has_player_name() -> bool:
+		if __player_name.value != null:
+			return true
+		return false
+	func get_player_name() -> String:
+		return __player_name.value
+	func clear_player_name() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__player_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_player_name(value : String) -> void:
+		__player_name.value = value
+	
+	var __room: PBField
+	func has_room() -> bool:
+		if __room.value != null:
+			return true
+		return false
+	func get_room() -> String:
+		return __room.value
+	func clear_room() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__room.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_room(value : String) -> void:
+		__room.value = value
+	
+	var __started_at: PBField
+	func has_started_at() -> bool:
+		if __started_at.value != null:
+			return true
+		return false
+	func get_started_at() -> int:
+		return __started_at.value
+	func clear_started_at() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__started_at.value = DEFAULT_VALUES_3[PB_DATA_TYPE.INT64]
+	func set_started_at(value : int) -> void:
+		__started_at.value = value
+	
+	var __ended_at: PBField
+	func has_ended_at() -> bool:
+		if __ended_at.value != null:
+			return true
+		return false
+	func get_ended_at() -> int:
+		return __ended_at.value
+	func clear_ended_at() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__ended_at.value = DEFAULT_VALUES_3[PB_DATA_TYPE.INT64]
+	func set_ended_at(value : int) -> void:
+		__ended_at.value = value
+	
+	var __end_cause: PBField
+	func has_end_cause() -> bool:
+		if __end_cause.value != null:
+			return true
+		return false
+	func get_end_cause() -> String:
+		return __end_cause.value
+	func clear_end_cause() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__end_cause.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_end_cause(value : String) -> void:
+		__end_cause.value = value
+	
+	var __killer_name: PBField
+	func has_killer_name() -> bool:
+		if __killer_name.value != null:
+			return true
+		return false
+	func get_killer_name() -> String:
+		return __killer_name.value
+	func clear_killer_name() -> void:
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__killer_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_killer_name(value : String) -> void:
+		__killer_name.value = value
+	
+	var __peak_mass: PBField
+	func has_peak_mass() -> bool:
+		if __peak_mass.value != null:
+			return true
+		return false
+	func get_peak_mass() -> float:
+		return __peak_mass.value
+	func clear_peak_mass() -> void:
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__peak_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_peak_mass(value : float) -> void:
+		__peak_mass.value = value
+	
+	var __spores_eaten: PBField
+	func has_spores_eaten() -> bool:
+		if __spores_eaten.value != null:
+			return true
+		return false
+	func get_spores_eaten() -> int:
+		return __spores_eaten.value
+	func clear_spores_eaten() -> void:
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__spores_eaten.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_spores_eaten(value : int) -> void:
+		__spores_eaten.value = value
+	
+	var __players_eaten: PBField
+	func has_players_eaten() -> bool:
+		if __players_eaten.value != null:
+			return true
+		return false
+	func get_players_eaten() -> int:
+		return __players_eaten.value
+	func clear_players_eaten() -> void:
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__players_eaten.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_players_eaten(value : int) -> void:
+		__players_eaten.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class HistoryMessage:
+	func _init():
+		var service
+		
+		var __sessions_default: Array[SessionMessage] = []
+		__sessions = PBField.new("sessions", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 1, true, __sessions_default)
+		service = PBServiceField.new()
+		service.field = __sessions
+		service.func_ref = Callable(self, "add_sessions")
+		data[__sessions.tag] = service
+		
+	var data = {}
+	
+	var __sessions: PBField
+	func get_sessions() -> Array[SessionMessage]:
+		return __sessions.value
+	func clear_sessions() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__sessions.value.clear()
+	func add_sessions() -> SessionMessage:
+		var element = SessionMessage.new()
+		__sessions.value.append(element)
+		return element
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class AchievementUnlockedMessage:
+	func _init():
+		var service
+		
+		__id = PBField.new("id", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __id
+		data[__id.tag] = service
+		
+		__name = PBField.new("name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __name
+		data[__name.tag] = service
+		
+		__description = PBField.new("description", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __description
+		data[__description.tag] = service
+		
+	var data = {}
+	
+	var __id: PBField
+	func has_id() -> bool:
+		if __id.value != null:
+			return true
+		return false
+	func get_id() -> String:
+		return __id.value
+	func clear_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_id(value : String) -> void:
+		__id.value = value
+	
+	var __name: PBField
+	func has_name() -> bool:
+		if __name.value != null:
+			return true
+		return false
+	func get_name() -> String:
+		return __name.value
+	func clear_name() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_name(value : String) -> void:
+		__name.value = value
+	
+	var __description: PBField
+	func has_description() -> bool:
+		if __description.value != null:
+			return true
+		return false
+	func get_description() -> String:
+		return __description.value
+	func clear_description() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__description.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_description(value : String) -> void:
+		__description.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class AddFriendRequestMessage:
+	func _init():
+		var service
+		
+		__username = PBField.new("username", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __username
+		data[__username.tag] = service
+		
+	var data = {}
+	
+	var __username: PBField
+	func has_username() -> bool:
+		if __username.value != null:
+			return true
+		return false
+	func get_username() -> String:
+		return __username.value
+	func clear_username() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__username.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_username(value : String) -> void:
+		__username.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class RemoveFriendRequestMessage:
+	func _init():
+		var service
+		
+		__username = PBField.new("username", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __username
+		data[__username.tag] = service
+		
+	var data = {}
+	
+	var __username: PBField
+	func has_username() -> bool:
+		if __username.value != null:
+			return true
+		return false
+	func get_username() -> String:
+		return __username.value
+	func clear_username() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__username.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_username(value : String) -> void:
+		__username.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class FriendListRequestMessage:
+	func _init():
+		var service
+		
+	var data = {}
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class FriendMessage:
+	func _init():
+		var service
+		
+		__username = PBField.new("username", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __username
+		data[__username.tag] = service
+		
+		__state = PBField.new("state", PB_DATA_TYPE.ENUM, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM])
+		service = PBServiceField.new()
+		service.field = __state
+		data[__state.tag] = service
+		
+		__online = PBField.new("online", PB_DATA_TYPE.BOOL, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL])
+		service = PBServiceField.new()
+		service.field = __online
+		data[__online.tag] = service
+		
+		__room = PBField.new("room", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __room
+		data[__room.tag] = service
+		
+		__status = PBField.new("status", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __status
+		data[__status.tag] = service
+		
+	var data = {}
+	
+	var __username: PBField
+	func has_username() -> bool:
+		if __username.value != null:
+			return true
+		return false
+	func get_username() -> String:
+		return __username.value
+	func clear_username() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__username.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_username(value : String) -> void:
+		__username.value = value
+	
+	var __state: PBField
+	func has_state() -> bool:
+		if __state.value != null:
+			return true
+		return false
+	func get_state():
+		return __state.value
+	func clear_state() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM]
+	func set_state(value) -> void:
+		__state.value = value
+	
+	var __online: PBField
+	func has_online() -> bool:
+		if __online.value != null:
+			return true
+		return false
+	func get_online() -> bool:
+		return __online.value
+	func clear_online() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__online.value = DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL]
+	func set_online(value : bool) -> void:
+		__online.value = value
+	
+	var __room: PBField
+	func has_room() -> bool:
+		if __room.value != null:
+			return true
+		return false
+	func get_room() -> String:
+		return __room.value
+	func clear_room() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__room.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_room(value : String) -> void:
+		__room.value = value
+	
+	var __status: PBField
+	func has_status() -> bool:
+		if __status.value != null:
+			return true
+		return false
+	func get_status() -> String:
+		return __status.value
+	func clear_status() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__status.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_status(value : String) -> void:
+		__status.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class FriendListMessage:
+	func _init():
+		var service
+		
+		var __friends_default: Array[FriendMessage] = []
+		__friends = PBField.new("friends", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 1, true, __friends_default)
+		service = PBServiceField.new()
+		service.field = __friends
+		service.func_ref = Callable(self, "add_friends")
+		data[__friends.tag] = service
+		
+	var data = {}
+	
+	var __friends: PBField
+	func get_friends() -> Array[FriendMessage]:
+		return __friends.value
+	func clear_friends() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__friends.value.clear()
+	func add_friends() -> FriendMessage:
+		var element = FriendMessage.new()
+		__friends.value.append(element)
+		return element
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class JoinFriendRequestMessage:
+	func _init():
+		var service
+		
+		__username = PBField.new("username", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __username
+		data[__username.tag] = service
+		
+	var data = {}
+	
+	var __username: PBField
+	func has_username() -> bool:
+		if __username.value != null:
+			return true
+		return false
+	func get_username() -> String:
+		return __username.value
+	func clear_username() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__username.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_username(value : String) -> void:
+		__username.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class PartyMemberMessage:
+	func _init():
+		var service
+		
+		__id = PBField.new("id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __id
+		data[__id.tag] = service
+		
+		__name = PBField.new("name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __name
+		data[__name.tag] = service
+		
+	var data = {}
+	
+	var __id: PBField
+	func has_id() -> bool:
+		if __id.value != null:
+			return true
+		return false
+	func get_id() -> int:
+		return __id.value
+	func clear_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_id(value : int) -> void:
+		__id.value = value
+	
+	var __name: PBField
+	func has_name() -> bool:
+		if __name.value != null:
+			return true
+		return false
+	func get_name() -> String:
+		return __name.value
+	func clear_name() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_name(value : String) -> void:
+		__name.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class PartyMessage:
+	func _init():
+		var service
+		
+		__party_id = PBField.new("party_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __party_id
+		data[__party_id.tag] = service
+		
+		__leader_id = PBField.new("leader_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __leader_id
+		data[__leader_id.tag] = service
+		
+		var __members_default: Array[PartyMemberMessage] = []
+		__members = PBField.new("members", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 3, true, __members_default)
+		service = PBServiceField.new()
+		service.field = __members
+		service.func_ref = Callable(self, "add_members")
+		data[__members.tag] = service
+		
+		__room = PBField.new("room", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __room
+		data[__room.tag] = service
+		
+	var data = {}
+	
+	var __party_id: PBField
+	func has_party_id() -> bool:
+		if __party_id.value != null:
+			return true
+		return false
+	func get_party_id() -> int:
+		return __party_id.value
+	func clear_party_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__party_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_party_id(value : int) -> void:
+		__party_id.value = value
+	
+	var __leader_id: PBField
+	func has_leader_id() -> bool:
+		if __leader_id.value != null:
+			return true
+		return false
+	func get_leader_id() -> int:
+		return __leader_id.value
+	func clear_leader_id() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__leader_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_leader_id(value : int) -> void:
+		__leader_id.value = value
+	
+	var __members: PBField
+	func get_members() -> Array[PartyMemberMessage]:
+		return __members.value
+	func clear_members() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__members.value.clear()
+	func add_members() -> PartyMemberMessage:
+		var element = PartyMemberMessage.new()
+		__members.value.append(element)
+		return element
+	
+	var __room: PBField
+	func has_room() -> bool:
+		if __room.value != null:
+			return true
+		return false
+	func get_room() -> String:
+		return __room.value
+	func clear_room() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__room.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_room(value : String) -> void:
+		__room.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class PartyCreateRequestMessage:
+	func _init():
+		var service
+		
+	var data = {}
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class PartyInviteRequestMessage:
+	func _init():
+		var service
+		
+		__name = PBField.new("name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __name
+		data[__name.tag] = service
+		
+	var data = {}
+	
+	var __name: PBField
+	func has_name() -> bool:
+		if __name.value != null:
+			return true
+		return false
+	func get_name() -> String:
+		return __name.value
+	func clear_name() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_name(value : String) -> void:
+		__name.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class PartyInviteMessage:
+	func _init():
+		var service
+		
+		__party_id = PBField.new("party_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __party_id
+		data[__party_id.tag] = service
+		
+		__leader_name = PBField.new("leader_name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __leader_name
+		data[__leader_name.tag] = service
+		
+	var data = {}
+	
+	var __party_id: PBField
+	func has_party_id() -> bool:
+		if __party_id.value != null:
+			return true
+		return false
+	func get_party_id() -> int:
+		return __party_id.value
+	func clear_party_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__party_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_party_id(value : int) -> void:
+		__party_id.value = value
+	
+	var __leader_name: PBField
+	func has_leader_name() -> bool:
+		if __leader_name.value != null:
+			return true
+		return false
+	func get_leader_name() -> String:
+		return __leader_name.value
+	func clear_leader_name() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__leader_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_leader_name(value : String) -> void:
+		__leader_name.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class PartyAcceptRequestMessage:
+	func _init():
+		var service
+		
+		__party_id = PBField.new("party_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __party_id
+		data[__party_id.tag] = service
+		
+	var data = {}
+	
+	var __party_id: PBField
+	func has_party_id() -> bool:
+		if __party_id.value != null:
+			return true
+		return false
+	func get_party_id() -> int:
+		return __party_id.value
+	func clear_party_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__party_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_party_id(value : int) -> void:
+		__party_id.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class PartyLeaveRequestMessage:
+	func _init():
+		var service
+		
+	var data = {}
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class PartyKickRequestMessage:
+	func _init():
+		var service
+		
+		__member_id = PBField.new("member_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __member_id
+		data[__member_id.tag] = service
+		
+	var data = {}
+	
+	var __member_id: PBField
+	func has_member_id() -> bool:
+		if __member_id.value != null:
+			return true
+		return false
+	func get_member_id() -> int:
+		return __member_id.value
+	func clear_member_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__member_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_member_id(value : int) -> void:
+		__member_id.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class PartyRoomRequestMessage:
+	func _init():
+		var service
+		
+		__room = PBField.new("room", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __room
+		data[__room.tag] = service
+		
+	var data = {}
+	
+	var __room: PBField
+	func has_room() -> bool:
+		if __room.value != null:
+			return true
+		return false
+	func get_room() -> String:
+		return __room.value
+	func clear_room() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__room.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_room(value : String) -> void:
+		__room.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class PlayerProfileRequestMessage:
+	func _init():
+		var service
+		
+		__username = PBField.new("username", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __username
+		data[__username.tag] = service
+		
+	var data = {}
+	
+	var __username: PBField
+	func has_username() -> bool:
+		if __username.value != null:
+			return true
+		return false
+	func get_username() -> String:
+		return __username.value
+	func clear_username() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__username.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_username(value : String) -> void:
+		__username.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class RatingChangeMessage:
+	func _init():
+		var service
+		
+		__rating = PBField.new("rating", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __rating
+		data[__rating.tag] = service
+		
+		__delta = PBField.new("delta", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __delta
+		data[__delta.tag] = service
+		
+		__placement = PBField.new("placement", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __placement
+		data[__placement.tag] = service
+		
+		__players = PBField.new("players", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __players
+		data[__players.tag] = service
+		
+		__kills = PBField.new("kills", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __kills
+		data[__kills.tag] = service
+		
+		__changed_at = PBField.new("changed_at", PB_DATA_TYPE.INT64, PB_RULE.OPTIONAL, 6, true, DEFAULT_VALUES_3[PB_DATA_TYPE.INT64])
+		service = PBServiceField.new()
+		service.field = __changed_at
+		data[__changed_at.tag] = service
+		
+	var data = {}
+	
+	var __rating: PBField
+	func has_rating() -> bool:
+		if __rating.value != null:
+			return true
+		return false
+	func get_rating() -> float:
+		return __rating.value
+	func clear_rating() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__rating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_rating(value : float) -> void:
+		__rating.value = value
+	
+	var __delta: PBField
+	func has_delta() -> bool:
+		if __delta.value != null:
+			return true
+		return false
+	func get_delta() -> float:
+		return __delta.value
+	func clear_delta() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_delta(value : float) -> void:
+		__delta.value = value
+	
+	var __placement: PBField
+	func has_placement() -> bool:
+		if __placement.value != null:
+			return true
+		return false
+	func get_placement() -> int:
+		return __placement.value
+	func clear_placement() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__placement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_placement(value : int) -> void:
+		__placement.value = value
+	
+	var __players: PBField
+	func has_players() -> bool:
+		if __players.value != null:
+			return true
+		return false
+	func get_players() -> int:
+		return __players.value
+	func clear_players() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__players.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_players(value : int) -> void:
+		__players.value = value
+	
+	var __kills: PBField
+	func has_kills() -> bool:
+		if __kills.value != null:
+			return true
+		return false
+	func get_kills() -> int:
+		return __kills.value
+	func clear_kills() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__kills.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_kills(value : int) -> void:
+		__kills.value = value
+	
+	var __changed_at: PBField
+	func has_changed_at() -> bool:
+		if __changed_at.value != null:
+			return true
+		return false
+	func get_changed_at() -> int:
+		return __changed_at.value
+	func clear_changed_at() -> void:
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__changed_at.value = DEFAULT_VALUES_3[PB_DATA_TYPE.INT64]
+	func set_changed_at(value : int) -> void:
+		__changed_at.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class PlayerProfileMessage:
+	func _init():
+		var service
+		
+		__username = PBField.new("username", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __username
+		data[__username.tag] = service
+		
+		__rating = PBField.new("rating", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __rating
+		data[__rating.tag] = service
+		
+		__rank = PBField.new("rank", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __rank
+		data[__rank.tag] = service
+		
+		var __history_default: Array[RatingChangeMessage] = []
+		__history = PBField.new("history", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 4, true, __history_default)
+		service = PBServiceField.new()
+		service.field = __history
+		service.func_ref = Callable(self, "add_history")
+		data[__history.tag] = service
+		
+	var data = {}
+	
+	var __username: PBField
+	func has_username() -> bool:
+		if __username.value != null:
+			return true
+		return false
+	func get_username() -> String:
+		return __username.value
+	func clear_username() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__username.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_username(value : String) -> void:
+		__username.value = value
+	
+	var __rating: PBField
+	func has_rating() -> bool:
+		if __rating.value != null:
+			return true
+		return false
+	func get_rating() -> float:
+		return __rating.value
+	func clear_rating() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__rating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_rating(value : float) -> void:
+		__rating.value = value
+	
+	var __rank: PBField
+	func has_rank() -> bool:
+		if __rank.value != null:
+			return true
+		return false
+	func get_rank() -> int:
+		return __rank.value
+	func clear_rank() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_rank(value : int) -> void:
+		__rank.value = value
+	
+	var __history: PBField
+	func get_history() -> Array[RatingChangeMessage]:
+		return __history.value
+	func clear_history() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__history.value.clear()
+	func add_history() -> RatingChangeMessage:
+		var element = RatingChangeMessage.new()
+		__history.value.append(element)
+		return element
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class LeaderboardRequestMessage:
+	func _init():
+		var service
+		
+		__limit = PBField.new("limit", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __limit
+		data[__limit.tag] = service
+		
+	var data = {}
+	
+	var __limit: PBField
+	func has_limit() -> bool:
+		if __limit.value != null:
+			return true
+		return false
+	func get_limit() -> int:
+		return __limit.value
+	func clear_limit() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__limit.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_limit(value : int) -> void:
+		__limit.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class LeaderboardEntryMessage:
+	func _init():
+		var service
+		
+		__rank = PBField.new("rank", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __rank
+		data[__rank.tag] = service
+		
+		__username = PBField.new("username", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __username
+		data[__username.tag] = service
+		
+		__rating = PBField.new("rating", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __rating
+		data[__rating.tag] = service
+		
+	var data = {}
+	
+	var __rank: PBField
+	func has_rank() -> bool:
+		if __rank.value != null:
+			return true
+		return false
+	func get_rank() -> int:
+		return __rank.value
+	func clear_rank() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_rank(value : int) -> void:
+		__rank.value = value
+	
+	var __username: PBField
+	func has_username() -> bool:
+		if __username.value != null:
+			return true
+		return false
+	func get_username() -> String:
+		return __username.value
+	func clear_username() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__username.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_username(value : String) -> void:
+		__username.value = value
+	
+	var __rating: PBField
+	func has_rating() -> bool:
+		if __rating.value != null:
+			return true
+		return false
+	func get_rating() -> float:
+		return __rating.value
+	func clear_rating() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__rating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_rating(value : float) -> void:
+		__rating.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class LeaderboardMessage:
+	func _init():
+		var service
+		
+		var __entries_default: Array[LeaderboardEntryMessage] = []
+		__entries = PBField.new("entries", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 1, true, __entries_default)
+		service = PBServiceField.new()
+		service.field = __entries
+		service.func_ref = Callable(self, "add_entries")
+		data[__entries.tag] = service
+		
+	var data = {}
+	
+	var __entries: PBField
+	func get_entries() -> Array[LeaderboardEntryMessage]:
+		return __entries.value
+	func clear_entries() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__entries.value.clear()
+	func add_entries() -> LeaderboardEntryMessage:
+		var element = LeaderboardEntryMessage.new()
+		__entries.value.append(element)
+		return element
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class Packet:
+	func _init():
+		var service
+		
+		__sender_id = PBField.new("sender_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __sender_id
+		data[__sender_id.tag] = service
+		
+		__chat = PBField.new("chat", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __chat
+		service.func_ref = Callable(self, "new_chat")
+		data[__chat.tag] = service
+		
+		__id = PBField.new("id", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __id
+		service.func_ref = Callable(self, "new_id")
+		data[__id.tag] = service
+		
+		__login_request = PBField.new("login_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __login_request
+		service.func_ref = Callable(self, "new_login_request")
+		data[__login_request.tag] = service
+		
+		__guest_login_request = PBField.new("guest_login_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __guest_login_request
+		service.func_ref = Callable(self, "new_guest_login_request")
+		data[__guest_login_request.tag] = service
+		
+		__register_request = PBField.new("register_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 6, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __register_request
+		service.func_ref = Callable(self, "new_register_request")
+		data[__register_request.tag] = service
+		
+		__ok_response = PBField.new("ok_response", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 7, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __ok_response
+		service.func_ref = Callable(self, "new_ok_response")
+		data[__ok_response.tag] = service
+		
+		__deny_response = PBField.new("deny_response", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 8, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __deny_response
+		service.func_ref = Callable(self, "new_deny_response")
+		data[__deny_response.tag] = service
+		
+		__player = PBField.new("player", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 9, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __player
+		service.func_ref = Callable(self, "new_player")
+		data[__player.tag] = service
+		
+		__player_direction = PBField.new("player_direction", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 10, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __player_direction
+		service.func_ref = Callable(self, "new_player_direction")
+		data[__player_direction.tag] = service
+		
+		__spore = PBField.new("spore", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 11, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __spore
+		service.func_ref = Callable(self, "new_spore")
+		data[__spore.tag] = service
+		
+		__spore_consumed = PBField.new("spore_consumed", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 12, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __spore_consumed
+		service.func_ref = Callable(self, "new_spore_consumed")
+		data[__spore_consumed.tag] = service
+		
+		__spores_batch = PBField.new("spores_batch", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 13, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __spores_batch
+		service.func_ref = Callable(self, "new_spores_batch")
+		data[__spores_batch.tag] = service
+		
+		__player_consumed = PBField.new("player_consumed", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 14, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __player_consumed
+		service.func_ref = Callable(self, "new_player_consumed")
+		data[__player_consumed.tag] = service
+		
+		__disconnect = PBField.new("disconnect", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 15, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __disconnect
+		service.func_ref = Callable(self, "new_disconnect")
+		data[__disconnect.tag] = service
+		
+		__team_scoreboard = PBField.new("team_scoreboard", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 16, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __team_scoreboard
+		service.func_ref = Callable(self, "new_team_scoreboard")
+		data[__team_scoreboard.tag] = service
+		
+		__round_state = PBField.new("round_state", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 17, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __round_state
+		service.func_ref = Callable(self, "new_round_state")
+		data[__round_state.tag] = service
+		
+		__safe_zone = PBField.new("safe_zone", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 18, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __safe_zone
+		service.func_ref = Callable(self, "new_safe_zone")
+		data[__safe_zone.tag] = service
+		
+		__power_up = PBField.new("power_up", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 19, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __power_up
+		service.func_ref = Callable(self, "new_power_up")
+		data[__power_up.tag] = service
+		
+		__power_up_consumed = PBField.new("power_up_consumed", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 20, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __power_up_consumed
+		service.func_ref = Callable(self, "new_power_up_consumed")
+		data[__power_up_consumed.tag] = service
+		
+		__player_effects = PBField.new("player_effects", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 21, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __player_effects
+		service.func_ref = Callable(self, "new_player_effects")
+		data[__player_effects.tag] = service
+		
+		__death = PBField.new("death", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 22, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __death
+		service.func_ref = Callable(self, "new_death")
+		data[__death.tag] = service
+		
+		__respawn_request = PBField.new("respawn_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 23, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __respawn_request
+		service.func_ref = Callable(self, "new_respawn_request")
+		data[__respawn_request.tag] = service
+		
+		__report_message = PBField.new("report_message", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 24, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __report_message
+		service.func_ref = Callable(self, "new_report_message")
+		data[__report_message.tag] = service
+		
+		__chat_history = PBField.new("chat_history", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 25, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __chat_history
+		service.func_ref = Callable(self, "new_chat_history")
+		data[__chat_history.tag] = service
+		
+		__change_password_request = PBField.new("change_password_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 26, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __change_password_request
+		service.func_ref = Callable(self, "new_change_password_request")
+		data[__change_password_request.tag] = service
+		
+		__reset_password_request = PBField.new("reset_password_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 27, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __reset_password_request
+		service.func_ref = Callable(self, "new_reset_password_request")
+		data[__reset_password_request.tag] = service
+		
+		__delete_account_request = PBField.new("delete_account_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 28, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __delete_account_request
+		service.func_ref = Callable(self, "new_delete_account_request")
+		data[__delete_account_request.tag] = service
+		
+		__profile = PBField.new("profile", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 29, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __profile
+		service.func_ref = Callable(self, "new_profile")
+		data[__profile.tag] = service
+		
+		__get_profile_request = PBField.new("get_profile_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 30, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __get_profile_request
+		service.func_ref = Callable(self, "new_get_profile_request")
+		data[__get_profile_request.tag] = service
+		
+		__history_request = PBField.new("history_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 31, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __history_request
+		service.func_ref = Callable(self, "new_history_request")
+		data[__history_request.tag] = service
+		
+		__history = PBField.new("history", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 32, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __history
+		service.func_ref = Callable(self, "new_history")
+		data[__history.tag] = service
+		
+		__achievement_unlocked = PBField.new("achievement_unlocked", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 33, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __achievement_unlocked
+		service.func_ref = Callable(self, "new_achievement_unlocked")
+		data[__achievement_unlocked.tag] = service
+		
+		__add_friend_request = PBField.new("add_friend_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 34, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __add_friend_request
+		service.func_ref = Callable(self, "new_add_friend_request")
+		data[__add_friend_request.tag] = service
+		
+		__remove_friend_request = PBField.new("remove_friend_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 35, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __remove_friend_request
+		service.func_ref = Callable(self, "new_remove_friend_request")
+		data[__remove_friend_request.tag] = service
+		
+		__friend_list_request = PBField.new("friend_list_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 36, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __friend_list_request
+		service.func_ref = Callable(self, "new_friend_list_request")
+		data[__friend_list_request.tag] = service
+		
+		__friend_list = PBField.new("friend_list", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 37, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __friend_list
+		service.func_ref = Callable(self, "new_friend_list")
+		data[__friend_list.tag] = service
+		
+		__friend = PBField.new("friend", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 38, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __friend
+		service.func_ref = Callable(self, "new_friend")
+		data[__friend.tag] = service
+		
+		__join_friend_request = PBField.new("join_friend_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 39, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __join_friend_request
+		service.func_ref = Callable(self, "new_join_friend_request")
+		data[__join_friend_request.tag] = service
+		
+		__party = PBField.new("party", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 40, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __party
+		service.func_ref = Callable(self, "new_party")
+		data[__party.tag] = service
+		
+		__party_create_request = PBField.new("party_create_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 41, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __party_create_request
+		service.func_ref = Callable(self, "new_party_create_request")
+		data[__party_create_request.tag] = service
+		
+		__party_invite_request = PBField.new("party_invite_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 42, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __party_invite_request
+		service.func_ref = Callable(self, "new_party_invite_request")
+		data[__party_invite_request.tag] = service
+		
+		__party_invite = PBField.new("party_invite", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 43, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __party_invite
+		service.func_ref = Callable(self, "new_party_invite")
+		data[__party_invite.tag] = service
+		
+		__party_accept_request = PBField.new("party_accept_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 44, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __party_accept_request
+		service.func_ref = Callable(self, "new_party_accept_request")
+		data[__party_accept_request.tag] = service
+		
+		__party_leave_request = PBField.new("party_leave_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 45, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __party_leave_request
+		service.func_ref = Callable(self, "new_party_leave_request")
+		data[__party_leave_request.tag] = service
+		
+		__party_kick_request = PBField.new("party_kick_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 46, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __party_kick_request
+		service.func_ref = Callable(self, "new_party_kick_request")
+		data[__party_kick_request.tag] = service
+		
+		__party_room_request = PBField.new("party_room_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 47, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __party_room_request
+		service.func_ref = Callable(self, "new_party_room_request")
+		data[__party_room_request.tag] = service
+		
+		__player_profile_request = PBField.new("player_profile_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 48, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __player_profile_request
+		service.func_ref = Callable(self, "new_player_profile_request")
+		data[__player_profile_request.tag] = service
+		
+		__player_profile = PBField.new("player_profile", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 49, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __player_profile
+		service.func_ref = Callable(self, "new_player_profile")
+		data[__player_profile.tag] = service
+		
+		__leaderboard_request = PBField.new("leaderboard_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 50, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __leaderboard_request
+		service.func_ref = Callable(self, "new_leaderboard_request")
+		data[__leaderboard_request.tag] = service
+		
+		__leaderboard = PBField.new("leaderboard", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 51, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __leaderboard
+		service.func_ref = Callable(self, "new_leaderboard")
+		data[__leaderboard.tag] = service
+		
+		__world_reset = PBField.new("world_reset", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 52, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __world_reset
+		service.func_ref = Callable(self, "new_world_reset")
+		data[__world_reset.tag] = service
+		
+	var data = {}
+	
+	var __sender_id: PBField
+	func has_sender_id() -> bool:
+		if __sender_id.value != null:
+			return true
+		return false
+	func get_sender_id() -> int:
+		return __sender_id.value
+	func clear_sender_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__sender_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_sender_id(value : int) -> void:
+		__sender_id.value = value
+	
+	var __chat: PBField
+	func has_chat() -> bool:
+		if __chat.value != null:
+			return true
+		return false
+	func get_chat() -> ChatMessage:
+		return __chat.value
+	func clear_chat() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_chat() -> ChatMessage:
+		data[2].state = PB_SERVICE_STATE.FILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__chat.value = ChatMessage.new()
+		return __chat.value
+	
+	var __id: PBField
+	func has_id() -> bool:
+		if __id.value != null:
+			return true
+		return false
+	func get_id() -> IdMessage:
+		return __id.value
+	func clear_id() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_id() -> IdMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		data[3].state = PB_SERVICE_STATE.FILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = IdMessage.new()
+		return __id.value
+	
+	var __login_request: PBField
+	func has_login_request() -> bool:
+		if __login_request.value != null:
+			return true
+		return false
+	func get_login_request() -> LoginRequestMessage:
+		return __login_request.value
+	func clear_login_request() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_login_request() -> LoginRequestMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		data[4].state = PB_SERVICE_STATE.FILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = LoginRequestMessage.new()
+		return __login_request.value
+	
+	var __guest_login_request: PBField
+	func has_guest_login_request() -> bool:
+		if __guest_login_request.value != null:
+			return true
+		return false
+	func get_guest_login_request() -> GuestLoginRequestMessage:
+		return __guest_login_request.value
+	func clear_guest_login_request() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_guest_login_request() -> GuestLoginRequestMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		data[5].state = PB_SERVICE_STATE.FILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = GuestLoginRequestMessage.new()
+		return __guest_login_request.value
+	
+	var __register_request: PBField
+	func has_register_request() -> bool:
+		if __register_request.value != null:
+			return true
+		return false
+	func get_register_request() -> RegisterRequestMessage:
+		return __register_request.value
+	func clear_register_request() -> void:
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_register_request() -> RegisterRequestMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		data[6].state = PB_SERVICE_STATE.FILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = RegisterRequestMessage.new()
+		return __register_request.value
+	
+	var __ok_response: PBField
+	func has_ok_response() -> bool:
+		if __ok_response.value != null:
+			return true
+		return false
+	func get_ok_response() -> OkResponseMessage:
+		return __ok_response.value
+	func clear_ok_response() -> void:
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_ok_response() -> OkResponseMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		data[7].state = PB_SERVICE_STATE.FILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = OkResponseMessage.new()
+		return __ok_response.value
+	
+	var __deny_response: PBField
+	func has_deny_response() -> bool:
+		if __deny_response.value != null:
+			return true
+		return false
+	func get_deny_response() -> DenyResponseMessage:
+		return __deny_response.value
+	func clear_deny_response() -> void:
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_deny_response() -> DenyResponseMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		data[8].state = PB_SERVICE_STATE.FILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DenyResponseMessage.new()
+		return __deny_response.value
+	
+	var __player: PBField
+	func has_player() -> bool:
+		if __player.value != null:
+			return true
+		return false
+	func get_player() -> PlayerMessage:
+		return __player.value
+	func clear_player() -> void:
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_player() -> PlayerMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		data[9].state = PB_SERVICE_STATE.FILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = PlayerMessage.new()
+		return __player.value
+	
+	var __player_direction: PBField
+	func has_player_direction() -> bool:
+		if __player_direction.value != null:
+			return true
+		return false
+	func get_player_direction() -> PlayerDirectionMessage:
+		return __player_direction.value
+	func clear_player_direction() -> void:
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_player_direction() -> PlayerDirectionMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		data[10].state = PB_SERVICE_STATE.FILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = PlayerDirectionMessage.new()
+		return __player_direction.value
+	
+	var __spore: PBField
+	func has_spore() -> bool:
+		if __spore.value != null:
+			return true
+		return false
+	func get_spore() -> SporeMessage:
+		return __spore.value
+	func clear_spore() -> void:
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_spore() -> SporeMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		data[11].state = PB_SERVICE_STATE.FILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = SporeMessage.new()
+		return __spore.value
+	
+	var __spore_consumed: PBField
+	func has_spore_consumed() -> bool:
+		if __spore_consumed.value != null:
+			return true
+		return false
+	func get_spore_consumed() -> SporeConsumedMessage:
+		return __spore_consumed.value
+	func clear_spore_consumed() -> void:
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_spore_consumed() -> SporeConsumedMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		data[12].state = PB_SERVICE_STATE.FILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = SporeConsumedMessage.new()
+		return __spore_consumed.value
+	
+	var __spores_batch: PBField
+	func has_spores_batch() -> bool:
+		if __spores_batch.value != null:
+			return true
+		return false
+	func get_spores_batch() -> SporesBatchMessage:
+		return __spores_batch.value
+	func clear_spores_batch() -> void:
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_spores_batch() -> SporesBatchMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		data[13].state = PB_SERVICE_STATE.FILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = SporesBatchMessage.new()
+		return __spores_batch.value
+	
+	var __player_consumed: PBField
+	func has_player_consumed() -> bool:
+		if __player_consumed.value != null:
+			return true
+		return false
+	func get_player_consumed() -> PlayerConsumedMessage:
+		return __player_consumed.value
+	func clear_player_consumed() -> void:
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_player_consumed() -> PlayerConsumedMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		data[14].state = PB_SERVICE_STATE.FILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = PlayerConsumedMessage.new()
+		return __player_consumed.value
+	
+	var __disconnect: PBField
+	func has_disconnect() -> bool:
+		if __disconnect.value != null:
+			return true
+		return false
+	func get_disconnect() -> DisconnectMessage:
+		return __disconnect.value
+	func clear_disconnect() -> void:
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_disconnect() -> DisconnectMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		data[15].state = PB_SERVICE_STATE.FILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DisconnectMessage.new()
+		return __disconnect.value
+	
+	var __team_scoreboard: PBField
+	func has_team_scoreboard() -> bool:
+		if __team_scoreboard.value != null:
+			return true
+		return false
+	func get_team_scoreboard() -> TeamScoreboardMessage:
+		return __team_scoreboard.value
+	func clear_team_scoreboard() -> void:
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_team_scoreboard() -> TeamScoreboardMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		data[16].state = PB_SERVICE_STATE.FILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = TeamScoreboardMessage.new()
+		return __team_scoreboard.value
+	
+	var __round_state: PBField
+	func has_round_state() -> bool:
+		if __round_state.value != null:
+			return true
+		return false
+	func get_round_state() -> RoundStateMessage:
+		return __round_state.value
+	func clear_round_state() -> void:
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_round_state() -> RoundStateMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		data[17].state = PB_SERVICE_STATE.FILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = RoundStateMessage.new()
+		return __round_state.value
+	
+	var __safe_zone: PBField
+	func has_safe_zone() -> bool:
+		if __safe_zone.value != null:
+			return true
+		return false
+	func get_safe_zone() -> SafeZoneMessage:
+		return __safe_zone.value
+	func clear_safe_zone() -> void:
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_safe_zone() -> SafeZoneMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		data[18].state = PB_SERVICE_STATE.FILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = SafeZoneMessage.new()
+		return __safe_zone.value
+	
+	var __power_up: PBField
+	func has_power_up() -> bool:
+		if __power_up.value != null:
+			return true
+		return false
+	func get_power_up() -> PowerUpMessage:
+		return __power_up.value
+	func clear_power_up() -> void:
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_power_up() -> PowerUpMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		data[19].state = PB_SERVICE_STATE.FILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = PowerUpMessage.new()
+		return __power_up.value
+	
+	var __power_up_consumed: PBField
+	func has_power_up_consumed() -> bool:
+		if __power_up_consumed.value != null:
+			return true
+		return false
+	func get_power_up_consumed() -> PowerUpConsumedMessage:
+		return __power_up_consumed.value
+	func clear_power_up_consumed() -> void:
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_power_up_consumed() -> PowerUpConsumedMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		data[20].state = PB_SERVICE_STATE.FILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = PowerUpConsumedMessage.new()
+		return __power_up_consumed.value
+	
+	var __player_effects: PBField
+	func has_player_effects() -> bool:
+		if __player_effects.value != null:
+			return true
+		return false
+	func get_player_effects() -> PlayerEffectsMessage:
+		return __player_effects.value
+	func clear_player_effects() -> void:
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_player_effects() -> PlayerEffectsMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		data[21].state = PB_SERVICE_STATE.FILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = PlayerEffectsMessage.new()
+		return __player_effects.value
+	
+	var __death: PBField
+	func has_death() -> bool:
+		if __death.value != null:
+			return true
+		return false
+	func get_death() -> DeathMessage:
+		return __death.value
+	func clear_death() -> void:
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_death() -> DeathMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		data[22].state = PB_SERVICE_STATE.FILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DeathMessage.new()
+		return __death.value
+	
+	var __respawn_request: PBField
+	func has_respawn_request() -> bool:
+		if __respawn_request.value != null:
+			return true
+		return false
+	func get_respawn_request() -> RespawnRequestMessage:
+		return __respawn_request.value
+	func clear_respawn_request() -> void:
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_respawn_request() -> RespawnRequestMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		data[23].state = PB_SERVICE_STATE.FILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = RespawnRequestMessage.new()
+		return __respawn_request.value
+	
+	var __report_message: PBField
+	func has_report_message() -> bool:
+		if __report_message.value != null:
+			return true
+		return false
+	func get_report_message() -> ReportMessageMessage:
+		return __report_message.value
+	func clear_report_message() -> void:
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_report_message() -> ReportMessageMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		data[24].state = PB_SERVICE_STATE.FILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = ReportMessageMessage.new()
+		return __report_message.value
+	
+	var __chat_history: PBField
+	func has_chat_history() -> bool:
+		if __chat_history.value != null:
+			return true
+		return false
+	func get_chat_history() -> ChatHistoryMessage:
+		return __chat_history.value
+	func clear_chat_history() -> void:
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_chat_history() -> ChatHistoryMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		data[25].state = PB_SERVICE_STATE.FILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = ChatHistoryMessage.new()
+		return __chat_history.value
+	
+	var __change_password_request: PBField
+	func has_change_password_request() -> bool:
+		if __change_password_request.value != null:
+			return true
+		return false
+	func get_change_password_request() -> ChangePasswordRequestMessage:
+		return __change_password_request.value
+	func clear_change_password_request() -> void:
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_change_password_request() -> ChangePasswordRequestMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		data[26].state = PB_SERVICE_STATE.FILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = ChangePasswordRequestMessage.new()
+		return __change_password_request.value
+	
+	var __reset_password_request: PBField
+	func has_reset_password_request() -> bool:
+		if __reset_password_request.value != null:
+			return true
+		return false
+	func get_reset_password_request() -> ResetPasswordRequestMessage:
+		return __reset_password_request.value
+	func clear_reset_password_request() -> void:
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_reset_password_request() -> ResetPasswordRequestMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		data[27].state = PB_SERVICE_STATE.FILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = ResetPasswordRequestMessage.new()
+		return __reset_password_request.value
+	
+	var __delete_account_request: PBField
+	func has_delete_account_request() -> bool:
+		if __delete_account_request.value != null:
+			return true
+		return false
+	func get_delete_account_request() -> DeleteAccountRequestMessage:
+		return __delete_account_request.value
+	func clear_delete_account_request() -> void:
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_delete_account_request() -> DeleteAccountRequestMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		data[28].state = PB_SERVICE_STATE.FILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DeleteAccountRequestMessage.new()
+		return __delete_account_request.value
+	
+	var __profile: PBField
+	func has_profile() -> bool:
+		if __profile.value != null:
+			return true
+		return false
+	func get_profile() -> ProfileMessage:
+		return __profile.value
+	func clear_profile() -> void:
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_profile() -> ProfileMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		data[29].state = PB_SERVICE_STATE.FILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = ProfileMessage.new()
+		return __profile.value
+	
+	var __get_profile_request: PBField
+	func has_get_profile_request() -> bool:
+		if __get_profile_request.value != null:
+			return true
+		return false
+	func get_get_profile_request() -> GetProfileRequestMessage:
+		return __get_profile_request.value
+	func clear_get_profile_request() -> void:
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_get_profile_request() -> GetProfileRequestMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		data[30].state = PB_SERVICE_STATE.FILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = GetProfileRequestMessage.new()
+		return __get_profile_request.value
+	
+	var __history_request: PBField
+	func has_history_request() -> bool:
+		if __history_request.value != null:
+			return true
+		return false
+	func get_history_request() -> HistoryRequestMessage:
+		return __history_request.value
+	func clear_history_request() -> void:
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_history_request() -> HistoryRequestMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		data[31].state = PB_SERVICE_STATE.FILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = HistoryRequestMessage.new()
+		return __history_request.value
+	
+	var __history: PBField
+	func has_history() -> bool:
+		if __history.value != null:
+			return true
+		return false
+	func get_history() -> HistoryMessage:
+		return __history.value
+	func clear_history() -> void:
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_history() -> HistoryMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		data[32].state = PB_SERVICE_STATE.FILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = HistoryMessage.new()
+		return __history.value
+	
+	var __achievement_unlocked: PBField
+	func has_achievement_unlocked() -> bool:
+		if __achievement_unlocked.value != null:
+			return true
+		return false
+	func get_achievement_unlocked() -> AchievementUnlockedMessage:
+		return __achievement_unlocked.value
+	func clear_achievement_unlocked() -> void:
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_achievement_unlocked() -> AchievementUnlockedMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		data[33].state = PB_SERVICE_STATE.FILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = AchievementUnlockedMessage.new()
+		return __achievement_unlocked.value
+	
+	var __add_friend_request: PBField
+	func has_add_friend_request() -> bool:
+		if __add_friend_request.value != null:
+			return true
+		return false
+	func get_add_friend_request() -> AddFriendRequestMessage:
+		return __add_friend_request.value
+	func clear_add_friend_request() -> void:
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_add_friend_request() -> AddFriendRequestMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		data[34].state = PB_SERVICE_STATE.FILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = AddFriendRequestMessage.new()
+		return __add_friend_request.value
+	
+	var __remove_friend_request: PBField
+	func has_remove_friend_request() -> bool:
+		if __remove_friend_request.value != null:
+			return true
+		return false
+	func get_remove_friend_request() -> RemoveFriendRequestMessage:
+		return __remove_friend_request.value
+	func clear_remove_friend_request() -> void:
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_remove_friend_request() -> RemoveFriendRequestMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		data[35].state = PB_SERVICE_STATE.FILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = RemoveFriendRequestMessage.new()
+		return __remove_friend_request.value
+	
+	var __friend_list_request: PBField
+	func has_friend_list_request() -> bool:
+		if __friend_list_request.value != null:
+			return true
+		return false
+	func get_friend_list_request() -> FriendListRequestMessage:
+		return __friend_list_request.value
+	func clear_friend_list_request() -> void:
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_friend_list_request() -> FriendListRequestMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		data[36].state = PB_SERVICE_STATE.FILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = FriendListRequestMessage.new()
+		return __friend_list_request.value
+	
+	var __friend_list: PBField
+	func has_friend_list() -> bool:
+		if __friend_list.value != null:
+			return true
+		return false
+	func get_friend_list() -> FriendListMessage:
+		return __friend_list.value
+	func clear_friend_list() -> void:
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_friend_list() -> FriendListMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		data[37].state = PB_SERVICE_STATE.FILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = FriendListMessage.new()
+		return __friend_list.value
+	
+	var __friend: PBField
+	func has_friend() -> bool:
+		if __friend.value != null:
+			return true
+		return false
+	func get_friend() -> FriendMessage:
+		return __friend.value
+	func clear_friend() -> void:
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_friend() -> FriendMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
 		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[6].state = PB_SERVICE_STATE.UNFILLED
 		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1737,25 +9256,99 @@ class Packet:
 		data[14].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[15].state = PB_SERVICE_STATE.UNFILLED
-		__id.value = IdMessage.new()
-		return __id.value
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		data[38].state = PB_SERVICE_STATE.FILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = FriendMessage.new()
+		return __friend.value
 	
-	var __login_request: PBField
-	func has_login_request() -> bool:
-		if __login_request.value != null:
+	var __join_friend_request: PBField
+	func has_join_friend_request() -> bool:
+		if __join_friend_request.value != null:
 			return true
 		return false
-	func get_login_request() -> LoginRequestMessage:
-		return __login_request.value
-	func clear_login_request() -> void:
-		data[4].state = PB_SERVICE_STATE.UNFILLED
-		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_login_request() -> LoginRequestMessage:
+	func get_join_friend_request() -> JoinFriendRequestMessage:
+		return __join_friend_request.value
+	func clear_join_friend_request() -> void:
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_join_friend_request() -> JoinFriendRequestMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[3].state = PB_SERVICE_STATE.UNFILLED
-		data[4].state = PB_SERVICE_STATE.FILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
 		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[5].state = PB_SERVICE_STATE.UNFILLED
 		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1778,27 +9371,101 @@ class Packet:
 		data[14].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[15].state = PB_SERVICE_STATE.UNFILLED
-		__login_request.value = LoginRequestMessage.new()
-		return __login_request.value
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		data[39].state = PB_SERVICE_STATE.FILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = JoinFriendRequestMessage.new()
+		return __join_friend_request.value
 	
-	var __guest_login_request: PBField
-	func has_guest_login_request() -> bool:
-		if __guest_login_request.value != null:
+	var __party: PBField
+	func has_party() -> bool:
+		if __party.value != null:
 			return true
 		return false
-	func get_guest_login_request() -> GuestLoginRequestMessage:
-		return __guest_login_request.value
-	func clear_guest_login_request() -> void:
-		data[5].state = PB_SERVICE_STATE.UNFILLED
-		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_guest_login_request() -> GuestLoginRequestMessage:
+	func get_party() -> PartyMessage:
+		return __party.value
+	func clear_party() -> void:
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_party() -> PartyMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[3].state = PB_SERVICE_STATE.UNFILLED
 		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[4].state = PB_SERVICE_STATE.UNFILLED
-		data[5].state = PB_SERVICE_STATE.FILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
 		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[6].state = PB_SERVICE_STATE.UNFILLED
 		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1819,20 +9486,93 @@ class Packet:
 		data[14].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[15].state = PB_SERVICE_STATE.UNFILLED
-		__guest_login_request.value = GuestLoginRequestMessage.new()
-		return __guest_login_request.value
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		data[40].state = PB_SERVICE_STATE.FILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = PartyMessage.new()
+		return __party.value
 	
-	var __register_request: PBField
-	func has_register_request() -> bool:
-		if __register_request.value != null:
+	var __party_create_request: PBField
+	func has_party_create_request() -> bool:
+		if __party_create_request.value != null:
 			return true
 		return false
-	func get_register_request() -> RegisterRequestMessage:
-		return __register_request.value
-	func clear_register_request() -> void:
-		data[6].state = PB_SERVICE_STATE.UNFILLED
-		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_register_request() -> RegisterRequestMessage:
+	func get_party_create_request() -> PartyCreateRequestMessage:
+		return __party_create_request.value
+	func clear_party_create_request() -> void:
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_party_create_request() -> PartyCreateRequestMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1841,7 +9581,8 @@ class Packet:
 		data[4].state = PB_SERVICE_STATE.UNFILLED
 		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[5].state = PB_SERVICE_STATE.UNFILLED
-		data[6].state = PB_SERVICE_STATE.FILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
 		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[7].state = PB_SERVICE_STATE.UNFILLED
 		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1860,20 +9601,93 @@ class Packet:
 		data[14].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[15].state = PB_SERVICE_STATE.UNFILLED
-		__register_request.value = RegisterRequestMessage.new()
-		return __register_request.value
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		data[41].state = PB_SERVICE_STATE.FILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = PartyCreateRequestMessage.new()
+		return __party_create_request.value
 	
-	var __ok_response: PBField
-	func has_ok_response() -> bool:
-		if __ok_response.value != null:
+	var __party_invite_request: PBField
+	func has_party_invite_request() -> bool:
+		if __party_invite_request.value != null:
 			return true
 		return false
-	func get_ok_response() -> OkResponseMessage:
-		return __ok_response.value
-	func clear_ok_response() -> void:
-		data[7].state = PB_SERVICE_STATE.UNFILLED
-		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_ok_response() -> OkResponseMessage:
+	func get_party_invite_request() -> PartyInviteRequestMessage:
+		return __party_invite_request.value
+	func clear_party_invite_request() -> void:
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_party_invite_request() -> PartyInviteRequestMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1884,7 +9698,8 @@ class Packet:
 		data[5].state = PB_SERVICE_STATE.UNFILLED
 		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[6].state = PB_SERVICE_STATE.UNFILLED
-		data[7].state = PB_SERVICE_STATE.FILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
 		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[8].state = PB_SERVICE_STATE.UNFILLED
 		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1901,20 +9716,93 @@ class Packet:
 		data[14].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[15].state = PB_SERVICE_STATE.UNFILLED
-		__ok_response.value = OkResponseMessage.new()
-		return __ok_response.value
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		data[42].state = PB_SERVICE_STATE.FILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = PartyInviteRequestMessage.new()
+		return __party_invite_request.value
 	
-	var __deny_response: PBField
-	func has_deny_response() -> bool:
-		if __deny_response.value != null:
+	var __party_invite: PBField
+	func has_party_invite() -> bool:
+		if __party_invite.value != null:
 			return true
 		return false
-	func get_deny_response() -> DenyResponseMessage:
-		return __deny_response.value
-	func clear_deny_response() -> void:
-		data[8].state = PB_SERVICE_STATE.UNFILLED
-		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_deny_response() -> DenyResponseMessage:
+	func get_party_invite() -> PartyInviteMessage:
+		return __party_invite.value
+	func clear_party_invite() -> void:
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_party_invite() -> PartyInviteMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1927,7 +9815,8 @@ class Packet:
 		data[6].state = PB_SERVICE_STATE.UNFILLED
 		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[7].state = PB_SERVICE_STATE.UNFILLED
-		data[8].state = PB_SERVICE_STATE.FILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
 		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[9].state = PB_SERVICE_STATE.UNFILLED
 		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1942,20 +9831,93 @@ class Packet:
 		data[14].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[15].state = PB_SERVICE_STATE.UNFILLED
-		__deny_response.value = DenyResponseMessage.new()
-		return __deny_response.value
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		data[43].state = PB_SERVICE_STATE.FILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = PartyInviteMessage.new()
+		return __party_invite.value
 	
-	var __player: PBField
-	func has_player() -> bool:
-		if __player.value != null:
+	var __party_accept_request: PBField
+	func has_party_accept_request() -> bool:
+		if __party_accept_request.value != null:
 			return true
 		return false
-	func get_player() -> PlayerMessage:
-		return __player.value
-	func clear_player() -> void:
-		data[9].state = PB_SERVICE_STATE.UNFILLED
-		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_player() -> PlayerMessage:
+	func get_party_accept_request() -> PartyAcceptRequestMessage:
+		return __party_accept_request.value
+	func clear_party_accept_request() -> void:
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_party_accept_request() -> PartyAcceptRequestMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1970,7 +9932,8 @@ class Packet:
 		data[7].state = PB_SERVICE_STATE.UNFILLED
 		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[8].state = PB_SERVICE_STATE.UNFILLED
-		data[9].state = PB_SERVICE_STATE.FILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
 		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[10].state = PB_SERVICE_STATE.UNFILLED
 		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1983,20 +9946,93 @@ class Packet:
 		data[14].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[15].state = PB_SERVICE_STATE.UNFILLED
-		__player.value = PlayerMessage.new()
-		return __player.value
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		data[44].state = PB_SERVICE_STATE.FILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = PartyAcceptRequestMessage.new()
+		return __party_accept_request.value
 	
-	var __player_direction: PBField
-	func has_player_direction() -> bool:
-		if __player_direction.value != null:
+	var __party_leave_request: PBField
+	func has_party_leave_request() -> bool:
+		if __party_leave_request.value != null:
 			return true
 		return false
-	func get_player_direction() -> PlayerDirectionMessage:
-		return __player_direction.value
-	func clear_player_direction() -> void:
-		data[10].state = PB_SERVICE_STATE.UNFILLED
-		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_player_direction() -> PlayerDirectionMessage:
+	func get_party_leave_request() -> PartyLeaveRequestMessage:
+		return __party_leave_request.value
+	func clear_party_leave_request() -> void:
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_party_leave_request() -> PartyLeaveRequestMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2013,7 +10049,8 @@ class Packet:
 		data[8].state = PB_SERVICE_STATE.UNFILLED
 		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[9].state = PB_SERVICE_STATE.UNFILLED
-		data[10].state = PB_SERVICE_STATE.FILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
 		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[11].state = PB_SERVICE_STATE.UNFILLED
 		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2024,20 +10061,93 @@ class Packet:
 		data[14].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[15].state = PB_SERVICE_STATE.UNFILLED
-		__player_direction.value = PlayerDirectionMessage.new()
-		return __player_direction.value
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		data[45].state = PB_SERVICE_STATE.FILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = PartyLeaveRequestMessage.new()
+		return __party_leave_request.value
 	
-	var __spore: PBField
-	func has_spore() -> bool:
-		if __spore.value != null:
+	var __party_kick_request: PBField
+	func has_party_kick_request() -> bool:
+		if __party_kick_request.value != null:
 			return true
 		return false
-	func get_spore() -> SporeMessage:
-		return __spore.value
-	func clear_spore() -> void:
-		data[11].state = PB_SERVICE_STATE.UNFILLED
-		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_spore() -> SporeMessage:
+	func get_party_kick_request() -> PartyKickRequestMessage:
+		return __party_kick_request.value
+	func clear_party_kick_request() -> void:
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_party_kick_request() -> PartyKickRequestMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2056,7 +10166,8 @@ class Packet:
 		data[9].state = PB_SERVICE_STATE.UNFILLED
 		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[10].state = PB_SERVICE_STATE.UNFILLED
-		data[11].state = PB_SERVICE_STATE.FILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
 		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[12].state = PB_SERVICE_STATE.UNFILLED
 		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2065,20 +10176,93 @@ class Packet:
 		data[14].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[15].state = PB_SERVICE_STATE.UNFILLED
-		__spore.value = SporeMessage.new()
-		return __spore.value
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		data[46].state = PB_SERVICE_STATE.FILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = PartyKickRequestMessage.new()
+		return __party_kick_request.value
 	
-	var __spore_consumed: PBField
-	func has_spore_consumed() -> bool:
-		if __spore_consumed.value != null:
+	var __party_room_request: PBField
+	func has_party_room_request() -> bool:
+		if __party_room_request.value != null:
 			return true
 		return false
-	func get_spore_consumed() -> SporeConsumedMessage:
-		return __spore_consumed.value
-	func clear_spore_consumed() -> void:
-		data[12].state = PB_SERVICE_STATE.UNFILLED
-		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_spore_consumed() -> SporeConsumedMessage:
+	func get_party_room_request() -> PartyRoomRequestMessage:
+		return __party_room_request.value
+	func clear_party_room_request() -> void:
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_party_room_request() -> PartyRoomRequestMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2099,27 +10283,101 @@ class Packet:
 		data[10].state = PB_SERVICE_STATE.UNFILLED
 		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[11].state = PB_SERVICE_STATE.UNFILLED
-		data[12].state = PB_SERVICE_STATE.FILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
 		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[13].state = PB_SERVICE_STATE.UNFILLED
 		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[14].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[15].state = PB_SERVICE_STATE.UNFILLED
-		__spore_consumed.value = SporeConsumedMessage.new()
-		return __spore_consumed.value
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		data[47].state = PB_SERVICE_STATE.FILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = PartyRoomRequestMessage.new()
+		return __party_room_request.value
 	
-	var __spores_batch: PBField
-	func has_spores_batch() -> bool:
-		if __spores_batch.value != null:
+	var __player_profile_request: PBField
+	func has_player_profile_request() -> bool:
+		if __player_profile_request.value != null:
 			return true
 		return false
-	func get_spores_batch() -> SporesBatchMessage:
-		return __spores_batch.value
-	func clear_spores_batch() -> void:
-		data[13].state = PB_SERVICE_STATE.UNFILLED
-		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_spores_batch() -> SporesBatchMessage:
+	func get_player_profile_request() -> PlayerProfileRequestMessage:
+		return __player_profile_request.value
+	func clear_player_profile_request() -> void:
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_player_profile_request() -> PlayerProfileRequestMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2142,25 +10400,99 @@ class Packet:
 		data[11].state = PB_SERVICE_STATE.UNFILLED
 		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[12].state = PB_SERVICE_STATE.UNFILLED
-		data[13].state = PB_SERVICE_STATE.FILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
 		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[14].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[15].state = PB_SERVICE_STATE.UNFILLED
-		__spores_batch.value = SporesBatchMessage.new()
-		return __spores_batch.value
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		data[48].state = PB_SERVICE_STATE.FILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = PlayerProfileRequestMessage.new()
+		return __player_profile_request.value
 	
-	var __player_consumed: PBField
-	func has_player_consumed() -> bool:
-		if __player_consumed.value != null:
+	var __player_profile: PBField
+	func has_player_profile() -> bool:
+		if __player_profile.value != null:
 			return true
 		return false
-	func get_player_consumed() -> PlayerConsumedMessage:
-		return __player_consumed.value
-	func clear_player_consumed() -> void:
-		data[14].state = PB_SERVICE_STATE.UNFILLED
-		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_player_consumed() -> PlayerConsumedMessage:
+	func get_player_profile() -> PlayerProfileMessage:
+		return __player_profile.value
+	func clear_player_profile() -> void:
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_player_profile() -> PlayerProfileMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2185,23 +10517,327 @@ class Packet:
 		data[12].state = PB_SERVICE_STATE.UNFILLED
 		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[13].state = PB_SERVICE_STATE.UNFILLED
-		data[14].state = PB_SERVICE_STATE.FILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[15].state = PB_SERVICE_STATE.UNFILLED
-		__player_consumed.value = PlayerConsumedMessage.new()
-		return __player_consumed.value
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		data[49].state = PB_SERVICE_STATE.FILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = PlayerProfileMessage.new()
+		return __player_profile.value
 	
-	var __disconnect: PBField
-	func has_disconnect() -> bool:
-		if __disconnect.value != null:
+	var __leaderboard_request: PBField
+	func has_leaderboard_request() -> bool:
+		if __leaderboard_request.value != null:
 			return true
 		return false
-	func get_disconnect() -> DisconnectMessage:
-		return __disconnect.value
-	func clear_disconnect() -> void:
+	func get_leaderboard_request() -> LeaderboardRequestMessage:
+		return __leaderboard_request.value
+	func clear_leaderboard_request() -> void:
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_leaderboard_request() -> LeaderboardRequestMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		data[50].state = PB_SERVICE_STATE.FILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = LeaderboardRequestMessage.new()
+		return __leaderboard_request.value
+	
+	var __leaderboard: PBField
+	func has_leaderboard() -> bool:
+		if __leaderboard.value != null:
+			return true
+		return false
+	func get_leaderboard() -> LeaderboardMessage:
+		return __leaderboard.value
+	func clear_leaderboard() -> void:
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_leaderboard() -> LeaderboardMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__guest_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_disconnect() -> DisconnectMessage:
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		data[51].state = PB_SERVICE_STATE.FILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = LeaderboardMessage.new()
+		return __leaderboard.value
+	
+	var __world_reset: PBField
+	func has_world_reset() -> bool:
+		if __world_reset.value != null:
+			return true
+		return false
+	func get_world_reset() -> WorldResetMessage:
+		return __world_reset.value
+	func clear_world_reset() -> void:
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__world_reset.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_world_reset() -> WorldResetMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2228,9 +10864,83 @@ class Packet:
 		data[13].state = PB_SERVICE_STATE.UNFILLED
 		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[14].state = PB_SERVICE_STATE.UNFILLED
-		data[15].state = PB_SERVICE_STATE.FILLED
-		__disconnect.value = DisconnectMessage.new()
-		return __disconnect.value
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__team_scoreboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__round_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__power_up_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__player_effects.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__death.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__report_message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__change_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__reset_password_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__delete_account_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__get_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__history_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__add_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__remove_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__friend_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__friend.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__join_friend_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__party.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__party_create_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__party_invite.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__party_accept_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__party_leave_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__party_kick_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__party_room_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__player_profile.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		data[52].state = PB_SERVICE_STATE.FILLED
+		__world_reset.value = WorldResetMessage.new()
+		return __world_reset.value
 	
 	func _to_string() -> String:
 		return PBPacker.message_to_string(data)
@@ -0,0 +1,341 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base32"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/internal/server/moderation"
+	"server/internal/server/names"
+	"server/internal/server/storage"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const adminUsage = `Admin commands:
+  ban -user NAME | -ip ADDRESS[/BITS] [-reason TEXT] [-duration DURATION] [-by NAME]
+  unban BAN_ID
+  bans
+  role -user NAME -role player|moderator|admin
+  reports
+  chat [-user NAME] [-limit N]
+  reset-code -user NAME [-expires DURATION]
+  top-kills [-since DURATION] [-limit N]
+  top-ratings [-limit N]`
+
+// Run an admin command against the database instead of starting the server
+func runAdminCommand(args []string, dbPath string, accountsDb string) error {
+	dbPool, err := server.OpenDb(dbPath)
+	if err != nil {
+		return err
+	}
+	defer dbPool.Close()
+
+	users, err := server.OpenUsers(accountsDb, dbPool)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	queries := db.New(dbPool)
+
+	switch args[0] {
+	case "ban":
+		return banCommand(ctx, queries, users, args[1:])
+	case "unban":
+		return unbanCommand(ctx, queries, args[1:])
+	case "bans":
+		return listBansCommand(ctx, queries)
+	case "role":
+		return roleCommand(ctx, users, args[1:])
+	case "reports":
+		return listReportsCommand(ctx, queries)
+	case "chat":
+		return chatCommand(ctx, queries, args[1:])
+	case "reset-code":
+		return resetCodeCommand(ctx, users, args[1:])
+	case "top-kills":
+		return topKillsCommand(ctx, users, args[1:])
+	case "top-ratings":
+		return topRatingsCommand(ctx, users, args[1:])
+	default:
+		return fmt.Errorf("unknown command %q\n%s", args[0], adminUsage)
+	}
+}
+
+func banCommand(ctx context.Context, queries *db.Queries, users storage.Users, args []string) error {
+	flags := flag.NewFlagSet("ban", flag.ContinueOnError)
+	username := flags.String("user", "", "The username to ban, registered or not")
+	ip := flags.String("ip", "", "The IP address or CIDR range to ban")
+	reason := flags.String("reason", "No reason given", "Why the ban was issued, shown to the banned player")
+	duration := flags.Duration("duration", 0, "How long the ban lasts, 0 for forever")
+	issuedBy := flags.String("by", "admin", "Who issued the ban")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	params := moderation.BanParams{
+		Username: *username,
+		IPCIDR:   *ip,
+		Reason:   *reason,
+		IssuedBy: *issuedBy,
+		Duration: *duration,
+	}
+
+	// Link the ban to the account too if the name belongs to a registered user
+	if *username != "" {
+		user, err := users.GetUserByUsername(ctx, names.Normalize(*username))
+		if err == nil {
+			params.UserId = user.ID
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+	}
+
+	ban, err := moderation.Ban(ctx, queries, params)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Created ban %d\n", ban.ID)
+	return nil
+}
+
+func unbanCommand(ctx context.Context, queries *db.Queries, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: unban BAN_ID")
+	}
+
+	banId, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid ban id %q", args[0])
+	}
+
+	if err := moderation.Unban(ctx, queries, banId); err != nil {
+		return err
+	}
+
+	fmt.Printf("Removed ban %d\n", banId)
+	return nil
+}
+
+func listBansCommand(ctx context.Context, queries *db.Queries) error {
+	bans, err := moderation.ActiveBans(ctx, queries)
+	if err != nil {
+		return err
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tUSER ID\tUSERNAME\tIP\tREASON\tISSUED BY\tCREATED\tEXPIRES")
+	for _, ban := range bans {
+		expires := "never"
+		if ban.ExpiresAt.Valid {
+			expires = ban.ExpiresAt.Time.Local().Format(time.DateTime)
+		}
+		fmt.Fprintf(writer, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			ban.ID,
+			nullInt(ban.UserID),
+			nullString(ban.Username),
+			nullString(ban.IpCidr),
+			ban.Reason,
+			ban.IssuedBy,
+			ban.CreatedAt.Local().Format(time.DateTime),
+			expires,
+		)
+	}
+	return writer.Flush()
+}
+
+func roleCommand(ctx context.Context, users storage.Users, args []string) error {
+	flags := flag.NewFlagSet("role", flag.ContinueOnError)
+	username := flags.String("user", "", "The registered user whose role to change")
+	roleName := flags.String("role", "", "The new role: player, moderator or admin")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	role, err := moderation.ParseRole(*roleName)
+	if err != nil {
+		return err
+	}
+
+	user, err := users.GetUserByUsername(ctx, names.Normalize(*username))
+	if err != nil {
+		return fmt.Errorf("could not find user %q: %w", *username, err)
+	}
+
+	if _, err := users.SetUserRole(ctx, db.SetUserRoleParams{Role: string(role), ID: user.ID}); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s now has the %s role\n", user.Username, role)
+	return nil
+}
+
+// Print the reported chat messages, each followed by the conversation the reporter saw leading up to it
+func listReportsCommand(ctx context.Context, queries *db.Queries) error {
+	reports, err := queries.ListChatReports(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, report := range reports {
+		fmt.Printf("Report %d by %s at %s: %s\n",
+			report.ID,
+			report.ReporterName,
+			report.CreatedAt.Local().Format(time.DateTime),
+			report.Reason,
+		)
+		fmt.Printf("  %s: %s\n", report.ReportedName, report.Message)
+		for _, line := range strings.Split(report.Context, "\n") {
+			fmt.Printf("    %s\n", line)
+		}
+	}
+	return nil
+}
+
+// Print the stored public chat messages, oldest first
+func chatCommand(ctx context.Context, queries *db.Queries, args []string) error {
+	flags := flag.NewFlagSet("chat", flag.ContinueOnError)
+	username := flags.String("user", "", "Only show messages sent by this player")
+	limit := flags.Int64("limit", 50, "How many of the latest messages to show")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var messages []db.ChatMessage
+	var err error
+	if *username != "" {
+		messages, err = queries.ListChatMessagesBySender(ctx, db.ListChatMessagesBySenderParams{
+			SenderName: *username,
+			Limit:      *limit,
+		})
+	} else {
+		messages, err = queries.ListRecentChatMessages(ctx, *limit)
+	}
+	if err != nil {
+		return err
+	}
+
+	// The messages come newest first
+	for _, message := range slices.Backward(messages) {
+		fmt.Printf("[%s] %s: %s\n", message.CreatedAt.Local().Format(time.DateTime), message.SenderName, message.Message)
+	}
+	return nil
+}
+
+// Print the games in which players ate the most other players lately
+func topKillsCommand(ctx context.Context, users storage.Users, args []string) error {
+	flags := flag.NewFlagSet("top-kills", flag.ContinueOnError)
+	since := flags.Duration("since", 7*24*time.Hour, "How far back to look")
+	limit := flags.Int64("limit", 10, "How many games to show")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	sessions, err := users.ListTopKillSessions(ctx, db.ListTopKillSessionsParams{
+		EndedAt: time.Now().Add(-*since).UTC(),
+		Limit:   *limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "PLAYER\tKILLS\tPEAK MASS\tROOM\tENDED")
+	for _, session := range sessions {
+		fmt.Fprintf(writer, "%s\t%d\t%.0f\t%s\t%s\n",
+			session.PlayerName,
+			session.PlayersEaten,
+			session.PeakMass,
+			session.Room,
+			session.EndedAt.Local().Format(time.DateTime),
+		)
+	}
+	return writer.Flush()
+}
+
+// Print the highest rated users
+func topRatingsCommand(ctx context.Context, users storage.Users, args []string) error {
+	flags := flag.NewFlagSet("top-ratings", flag.ContinueOnError)
+	limit := flags.Int64("limit", 10, "How many users to show")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	ratings, err := users.ListTopRatings(ctx, *limit)
+	if err != nil {
+		return err
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "RANK\tUSER\tRATING")
+	for i, rating := range ratings {
+		fmt.Fprintf(writer, "%d\t%s\t%.0f\n", i+1, rating.Username, rating.Rating)
+	}
+	return writer.Flush()
+}
+
+// Issue a one-time code a user can set a new password with, e.g. when they forgot theirs
+func resetCodeCommand(ctx context.Context, users storage.Users, args []string) error {
+	flags := flag.NewFlagSet("reset-code", flag.ContinueOnError)
+	username := flags.String("user", "", "The registered user who needs a new password")
+	expires := flags.Duration("expires", time.Hour, "How long the code can be used for")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	user, err := users.GetUserByUsername(ctx, names.Normalize(*username))
+	if err != nil {
+		return fmt.Errorf("could not find user %q: %w", *username, err)
+	}
+
+	codeBytes := make([]byte, 10)
+	if _, err := rand.Read(codeBytes); err != nil {
+		return err
+	}
+	code := base32.StdEncoding.EncodeToString(codeBytes)
+
+	// Only the hash is stored, like for passwords
+	codeHash, err := bcrypt.GenerateFromPassword([]byte(code), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	err = users.CreatePasswordReset(ctx, db.CreatePasswordResetParams{
+		UserID:    user.ID,
+		CodeHash:  string(codeHash),
+		ExpiresAt: time.Now().Add(*expires).UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Reset code for %s, valid for %s: %s\n", user.Username, *expires, code)
+	return nil
+}
+
+func nullString(value sql.NullString) string {
+	if !value.Valid {
+		return "-"
+	}
+	return value.String
+}
+
+func nullInt(value sql.NullInt64) string {
+	if !value.Valid {
+		return "-"
+	}
+	return strconv.FormatInt(value.Int64, 10)
+}
@@ -4,19 +4,105 @@ import (
 	"flag"
 	"fmt"
 	"net/http"
+	"os"
 	"server/internal/server"
+	"server/internal/server/achievements"
 	"server/internal/server/clients"
+	"strconv"
+	"strings"
+	"time"
 )
 
 var (
-	port = flag.Int("port", 8080, "The port to listen on")
+	port  = flag.Int("port", 8080, "The port to listen on")
+	teams = flag.Int("teams", 0, "The number of teams players are split into in the first room and the rooms opened when it fills up, 0 for free-for-all")
+	mode  = flag.String("mode", server.ModeEndless, "The game mode, either endless or royale")
+	room  = flag.String("room", "main", "The name of the first room, rooms opened when it fills up are named after it")
+	rooms = flag.String("rooms", "", "Comma separated rooms which are always open besides the first one, as name or name:teams")
+
+	regions         = flag.String("regions", "", "Comma separated latency regions players can ask to play in, the first room is in the first one")
+	roomCapacity    = flag.Int("room-capacity", 30, "The most players a room takes")
+	maxRooms        = flag.Int("max-rooms", 8, "The most rooms which may be open at once")
+	roomIdleTimeout = flag.Duration("room-idle-timeout", 2*time.Minute, "How long a room opened when the others filled up stays open once its last player left")
+
+	powerUpRate = flag.Duration("powerup-rate", 10*time.Second, "How often a new power-up is spawned")
+	maxPowerUps = flag.Int("max-powerups", 20, "The maximum number of power-ups in the world at once")
+
+	respawnCooldown = flag.Duration("respawn-cooldown", 3*time.Second, "How long consumed players have to wait before they can respawn")
+
+	chatMaxLength = flag.Int("chat-max-length", 200, "The longest chat message a player may send, in characters")
+	chatWordList  = flag.String("chat-word-list", "", "A file of words to mask in chat, one per line")
+	persistChat   = flag.Bool("persist-chat", true, "Store public chat messages in the database so the history survives restarts")
+
+	achievementsFile = flag.String("achievements", "", "A JSON file of achievement definitions to use instead of the built-in ones")
+
+	dbPath     = flag.String("db", "db.sqlite", "The SQLite database file, or "+server.InMemoryDb+" to keep everything in memory")
+	accountsDb = flag.String("accounts-db", "", "A PostgreSQL connection string to keep user accounts in, shared by several servers; the local database is used if empty")
 )
 
 func main() {
 	flag.Parse()
 
+	if flag.NArg() > 0 {
+		if err := runAdminCommand(flag.Args(), *dbPath, *accountsDb); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *mode != server.ModeEndless && *mode != server.ModeRoyale {
+		fmt.Fprintf(os.Stderr, "unknown game mode %q, must be either %s or %s\n", *mode, server.ModeEndless, server.ModeRoyale)
+		os.Exit(1)
+	}
+	if *teams < 0 {
+		fmt.Fprintf(os.Stderr, "the number of teams must not be negative, got %d\n", *teams)
+		os.Exit(1)
+	}
+
+	extraRooms, err := parseRooms(*rooms, *room)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	config := server.DefaultConfig()
+	config.Teams = *teams
+	config.Mode = *mode
+	config.PowerUpSpawnRate = *powerUpRate
+	config.MaxPowerUps = *maxPowerUps
+	config.RespawnCooldown = *respawnCooldown
+	config.Room = *room
+	config.Rooms = extraRooms
+	config.Regions = splitList(*regions)
+	config.RoomCapacity = *roomCapacity
+	config.MaxRooms = *maxRooms
+	config.RoomIdleTimeout = *roomIdleTimeout
+	config.ChatMaxLength = *chatMaxLength
+	config.PersistChat = *persistChat
+	config.DbPath = *dbPath
+	config.AccountsDb = *accountsDb
+
+	if *chatWordList != "" {
+		words, err := readWordList(*chatWordList)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		config.ChatBlockedWords = words
+	}
+
+	if *achievementsFile != "" {
+		definitions, err := achievements.Load(*achievementsFile)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		config.Achievements = definitions
+	}
+
 	// Create a new hub
-	hub := server.NewHub()
+	hub := server.NewHub(config)
 
 	// Define the handlers for the websocket connnections
 	http.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
@@ -26,9 +112,59 @@ func main() {
 	go hub.Run()
 
 	addr := fmt.Sprintf(":%d", *port)
-	err := http.ListenAndServe(addr, nil)
+	err = http.ListenAndServe(addr, nil)
 
 	if err != nil {
 		panic(err)
 	}
 }
+
+// Read a word list file, skipping blank lines and lines starting with #
+func readWordList(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the chat word list: %w", err)
+	}
+
+	var words []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			words = append(words, line)
+		}
+	}
+	return words, nil
+}
+
+// Split a comma separated flag value, ignoring blanks
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// Parse the rooms flag, each room is its name optionally followed by a colon and its number of teams
+func parseRooms(value string, firstRoom string) ([]server.RoomConfig, error) {
+	var rooms []server.RoomConfig
+	seen := map[string]bool{firstRoom: true}
+	for _, item := range splitList(value) {
+		name, teams, hasTeams := strings.Cut(item, ":")
+		settings := server.RoomConfig{Name: strings.TrimSpace(name)}
+		if hasTeams {
+			count, err := strconv.Atoi(strings.TrimSpace(teams))
+			if err != nil || count < 0 {
+				return nil, fmt.Errorf("the number of teams of room %q must be a number which isn't negative, got %q", settings.Name, teams)
+			}
+			settings.Teams = count
+		}
+		if settings.Name == "" || seen[settings.Name] {
+			return nil, fmt.Errorf("every room needs a name of its own, got %q", item)
+		}
+		seen[settings.Name] = true
+		rooms = append(rooms, settings)
+	}
+	return rooms, nil
+}
@@ -0,0 +1,181 @@
+package achievements
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// What an achievement's goal is measured in
+type Stat string
+
+const (
+	// The player's current mass
+	StatMass Stat = "mass"
+	// Seconds since the player spawned
+	StatTimeAlive Stat = "time_alive"
+	// Spores and players eaten during this life
+	StatSporesEaten  Stat = "spores_eaten"
+	StatPlayersEaten Stat = "players_eaten"
+	// The mass of the biggest player eaten during this life
+	StatLargestKill Stat = "largest_kill"
+	// Spores and players eaten over every life the user played, including this one
+	StatTotalSporesEaten  Stat = "total_spores_eaten"
+	StatTotalPlayersEaten Stat = "total_players_eaten"
+)
+
+var stats = map[Stat]struct{}{
+	StatMass:              {},
+	StatTimeAlive:         {},
+	StatSporesEaten:       {},
+	StatPlayersEaten:      {},
+	StatLargestKill:       {},
+	StatTotalSporesEaten:  {},
+	StatTotalPlayersEaten: {},
+}
+
+// An achievement is unlocked once its stat reaches the goal
+type Definition struct {
+	Id          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Stat        Stat    `json:"stat"`
+	Goal        float64 `json:"goal"`
+}
+
+// The achievements the server comes with
+//
+//go:embed definitions.json
+var definitionsJson []byte
+
+var defaults = mustParse(definitionsJson)
+
+func Defaults() []Definition {
+	return append([]Definition(nil), defaults...)
+}
+
+// Read achievement definitions from a JSON file laid out like definitions.json
+func Load(path string) ([]Definition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the achievements: %w", err)
+	}
+	return Parse(data)
+}
+
+func Parse(data []byte) ([]Definition, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var definitions []Definition
+	if err := decoder.Decode(&definitions); err != nil {
+		return nil, fmt.Errorf("invalid achievements: %w", err)
+	}
+
+	ids := make(map[string]struct{}, len(definitions))
+	for i, definition := range definitions {
+		if definition.Id == "" || definition.Name == "" {
+			return nil, fmt.Errorf("achievement %d needs an id and a name", i+1)
+		}
+		if _, exists := ids[definition.Id]; exists {
+			return nil, fmt.Errorf("there is more than one achievement with the id %s", definition.Id)
+		}
+		ids[definition.Id] = struct{}{}
+
+		if _, exists := stats[definition.Stat]; !exists {
+			return nil, fmt.Errorf("achievement %s has an unknown stat %q", definition.Id, definition.Stat)
+		}
+		if definition.Goal <= 0 {
+			return nil, fmt.Errorf("achievement %s needs a goal above 0", definition.Id)
+		}
+	}
+	return definitions, nil
+}
+
+func mustParse(data []byte) []Definition {
+	definitions, err := Parse(data)
+	if err != nil {
+		panic(err)
+	}
+	return definitions
+}
+
+// How a player's current life is going
+type Stats struct {
+	Mass         float64
+	TimeAlive    time.Duration
+	SporesEaten  int64
+	PlayersEaten int64
+	LargestKill  float64
+}
+
+// What a user did in the lives they played before this one
+type Totals struct {
+	SporesEaten  int64
+	PlayersEaten int64
+}
+
+// Keeps track of the achievements a user hasn't unlocked yet
+type Tracker struct {
+	locked []Definition
+	totals Totals
+	mux    sync.Mutex
+}
+
+// Track the definitions the user doesn't have already, going by the ids of the ones they unlocked
+func NewTracker(definitions []Definition, unlocked []string, totals Totals) *Tracker {
+	have := make(map[string]struct{}, len(unlocked))
+	for _, id := range unlocked {
+		have[id] = struct{}{}
+	}
+
+	locked := make([]Definition, 0, len(definitions))
+	for _, definition := range definitions {
+		if _, exists := have[definition.Id]; !exists {
+			locked = append(locked, definition)
+		}
+	}
+	return &Tracker{locked: locked, totals: totals}
+}
+
+// Check the player's stats, returning the achievements which were just unlocked. Each one is only returned once.
+func (t *Tracker) Check(stats Stats) []Definition {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	var unlocked []Definition
+	stillLocked := t.locked[:0]
+	for _, definition := range t.locked {
+		if t.value(stats, definition.Stat) >= definition.Goal {
+			unlocked = append(unlocked, definition)
+		} else {
+			stillLocked = append(stillLocked, definition)
+		}
+	}
+	t.locked = stillLocked
+	return unlocked
+}
+
+func (t *Tracker) value(stats Stats, stat Stat) float64 {
+	switch stat {
+	case StatMass:
+		return stats.Mass
+	case StatTimeAlive:
+		return stats.TimeAlive.Seconds()
+	case StatSporesEaten:
+		return float64(stats.SporesEaten)
+	case StatPlayersEaten:
+		return float64(stats.PlayersEaten)
+	case StatLargestKill:
+		return stats.LargestKill
+	case StatTotalSporesEaten:
+		return float64(t.totals.SporesEaten + stats.SporesEaten)
+	case StatTotalPlayersEaten:
+		return float64(t.totals.PlayersEaten + stats.PlayersEaten)
+	}
+	return 0
+}
@@ -0,0 +1,217 @@
+package anticheat
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"sync"
+	"time"
+)
+
+// The kinds of suspicious behaviour the tracker knows about
+type Violation string
+
+const (
+	// The client claimed to consume something it couldn't have reached or wasn't allowed to consume
+	ImplausibleConsumption Violation = "implausible consumption"
+	// The client turned faster than any human could
+	DirectionChangeRate Violation = "direction change rate"
+	// The client sent a burst of packets at once, e.g. after holding them back
+	PacketTiming Violation = "packet timing"
+	// The client sent a player update for itself, which only the server is supposed to do
+	SelfPlayerUpdate Violation = "self player update"
+)
+
+// How many points each kind of violation adds to the score
+var weights = map[Violation]float64{
+	ImplausibleConsumption: 10,
+	DirectionChangeRate:    5,
+	PacketTiming:           5,
+	SelfPlayerUpdate:       20,
+}
+
+const (
+	// Points removed from the score every second, so occasional false positives fade away
+	scoreDecay = 1.0
+	// Above this score the client is shadow-flagged: it keeps playing but its consumption claims are ignored
+	FlagScore = 50.0
+	// Above this score the client is kicked
+	KickScore = 100.0
+
+	// The most a player can keep turning the same way per second, in radians. Turning back and forth cancels out, the
+	// game client does that every frame while the mouse is over the player.
+	maxTurnRate = 8 * math.Pi
+	// More packets than this within packetBurstWindow count as a burst
+	packetBurstWindow = 100 * time.Millisecond
+	maxPacketBurst    = 30
+)
+
+// What should happen to the client after a violation
+type Action string
+
+const (
+	ActionNone       Action = "none"
+	ActionShadowFlag Action = "shadow flag"
+	ActionKick       Action = "kick"
+)
+
+// Persisted record of a client who crossed one of the thresholds
+type Offense struct {
+	UserId     int64
+	PlayerName string
+	Score      float64
+	Action     Action
+	Details    string
+}
+
+// Keeps a violation score for a single client
+type Tracker struct {
+	logger    *log.Logger
+	onOffense func(Offense)
+
+	userId     int64
+	playerName string
+
+	score     float64
+	scoredAt  time.Time
+	counts    map[Violation]int
+	flagged   bool
+	kick      bool
+	lastEvent string
+
+	lastDirection  float64
+	turnWindow     time.Time
+	turnedInWindow float64
+
+	burstWindow    time.Time
+	packetsInBurst int
+
+	mux sync.Mutex
+}
+
+// Create a tracker which logs to the given logger and calls onOffense whenever the client is flagged or kicked. It's
+// called on the goroutine which reported the violation, so it shouldn't block.
+func NewTracker(logger *log.Logger, onOffense func(Offense)) *Tracker {
+	return &Tracker{
+		logger:    logger,
+		onOffense: onOffense,
+		scoredAt:  time.Now(),
+		counts:    make(map[Violation]int),
+	}
+}
+
+// Attach the player the client is currently playing as, so offenses can be linked to them
+func (t *Tracker) Identify(userId int64, playerName string) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	t.userId = userId
+	t.playerName = playerName
+}
+
+// Record a violation and return what should happen to the client
+func (t *Tracker) Report(violation Violation, details string) Action {
+	t.mux.Lock()
+	action, offense := t.raise(violation, details)
+	t.mux.Unlock()
+
+	if offense != nil && t.onOffense != nil {
+		t.onOffense(*offense)
+	}
+	return action
+}
+
+// Raise the score for a violation, returns the offense if a threshold was crossed for the first time. Must be called
+// with the lock held.
+func (t *Tracker) raise(violation Violation, details string) (Action, *Offense) {
+	now := time.Now()
+	t.score = max(t.score-now.Sub(t.scoredAt).Seconds()*scoreDecay, 0) + weights[violation]
+	t.scoredAt = now
+	t.counts[violation]++
+	t.lastEvent = fmt.Sprintf("%s: %s", violation, details)
+
+	t.logger.Printf("Anti-cheat: %s (score %.1f, %d times): %s", violation, t.score, t.counts[violation], details)
+
+	switch {
+	case t.score >= KickScore:
+		if !t.kick {
+			t.kick = true
+			return ActionKick, t.offense(ActionKick)
+		}
+		return ActionKick, nil
+	case t.score >= FlagScore:
+		if !t.flagged {
+			t.flagged = true
+			return ActionShadowFlag, t.offense(ActionShadowFlag)
+		}
+		return ActionShadowFlag, nil
+	}
+	return ActionNone, nil
+}
+
+// Whether the client has been shadow-flagged, which lasts until it disconnects
+func (t *Tracker) Flagged() bool {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	return t.flagged
+}
+
+// Whether the client should be kicked
+func (t *Tracker) ShouldKick() bool {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	return t.kick
+}
+
+// Keep track of incoming packets to spot bursts
+func (t *Tracker) ObservePacket() {
+	now := time.Now()
+
+	t.mux.Lock()
+	if now.Sub(t.burstWindow) > packetBurstWindow {
+		t.burstWindow = now
+		t.packetsInBurst = 0
+	}
+	t.packetsInBurst++
+	burst := t.packetsInBurst == maxPacketBurst+1
+	t.mux.Unlock()
+
+	if burst {
+		t.Report(PacketTiming, fmt.Sprintf("more than %d packets within %s", maxPacketBurst, packetBurstWindow))
+	}
+}
+
+// Keep track of how fast the player is turning, given the direction the server moves them in at every update. Each
+// update can turn them by half a circle at most, either way, so only turning faster than that can slip through.
+func (t *Tracker) ObserveDirection(direction float64) {
+	now := time.Now()
+
+	t.mux.Lock()
+	turned := math.Remainder(direction-t.lastDirection, 2*math.Pi)
+	t.lastDirection = direction
+	if now.Sub(t.turnWindow) > time.Second {
+		t.turnWindow = now
+		t.turnedInWindow = 0
+	}
+	t.turnedInWindow += turned
+	tooFast := math.Abs(t.turnedInWindow) > maxTurnRate
+	if tooFast {
+		t.turnedInWindow = 0
+	}
+	t.mux.Unlock()
+
+	if tooFast {
+		t.Report(DirectionChangeRate, fmt.Sprintf("turned more than %.1f radians the same way within a second", maxTurnRate))
+	}
+}
+
+// Must be called with the lock held
+func (t *Tracker) offense(action Action) *Offense {
+	t.logger.Printf("Anti-cheat: %s %q (user %d) with score %.1f", action, t.playerName, t.userId, t.score)
+	return &Offense{
+		UserId:     t.userId,
+		PlayerName: t.playerName,
+		Score:      t.score,
+		Action:     action,
+		Details:    fmt.Sprintf("%v, last: %s", t.counts, t.lastEvent),
+	}
+}
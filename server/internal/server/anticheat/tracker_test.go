@@ -0,0 +1,105 @@
+package anticheat
+
+import (
+	"io"
+	"log"
+	"testing"
+	"time"
+)
+
+func newTestTracker() (*Tracker, chan Offense) {
+	offenses := make(chan Offense, 10)
+	tracker := NewTracker(log.New(io.Discard, "", 0), func(offense Offense) { offenses <- offense })
+	tracker.Identify(7, "cheater")
+	return tracker, offenses
+}
+
+func expectOffense(t *testing.T, offenses chan Offense, action Action) {
+	t.Helper()
+	select {
+	case offense := <-offenses:
+		if offense.Action != action {
+			t.Errorf("expected a %s offense, got %s", action, offense.Action)
+		}
+		if offense.UserId != 7 || offense.PlayerName != "cheater" {
+			t.Errorf("expected the offense to name the identified player, got %d %q", offense.UserId, offense.PlayerName)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("expected a %s offense to be persisted", action)
+	}
+}
+
+func TestThresholds(t *testing.T) {
+	tracker, offenses := newTestTracker()
+
+	// Each of these is worth 20 points, so the third crosses the flag score and the sixth the kick score
+	actions := []Action{ActionNone, ActionNone, ActionShadowFlag, ActionShadowFlag, ActionShadowFlag, ActionKick}
+	for i, want := range actions {
+		if got := tracker.Report(SelfPlayerUpdate, "test"); got != want {
+			t.Fatalf("report %d: expected %s, got %s", i+1, want, got)
+		}
+		if i == 2 {
+			expectOffense(t, offenses, ActionShadowFlag)
+		}
+	}
+	expectOffense(t, offenses, ActionKick)
+
+	if !tracker.Flagged() || !tracker.ShouldKick() {
+		t.Error("expected the client to be flagged and kicked")
+	}
+	if tracker.Report(SelfPlayerUpdate, "test") != ActionKick {
+		t.Error("expected the client to stay kicked")
+	}
+	select {
+	case offense := <-offenses:
+		t.Errorf("expected every offense to be persisted once, got another %s", offense.Action)
+	default:
+	}
+}
+
+func TestScoreDecays(t *testing.T) {
+	tracker, _ := newTestTracker()
+	tracker.Report(SelfPlayerUpdate, "test")
+	tracker.Report(SelfPlayerUpdate, "test")
+	// Pretend the last report was long enough ago for the score to have faded
+	tracker.scoredAt = tracker.scoredAt.Add(-time.Minute)
+	if action := tracker.Report(SelfPlayerUpdate, "test"); action != ActionNone {
+		t.Errorf("expected old violations to have decayed, got %s", action)
+	}
+}
+
+func TestPacketBurst(t *testing.T) {
+	tracker, _ := newTestTracker()
+	for range maxPacketBurst {
+		tracker.ObservePacket()
+	}
+	if tracker.counts[PacketTiming] != 0 {
+		t.Fatalf("expected %d packets not to count as a burst", maxPacketBurst)
+	}
+	tracker.ObservePacket()
+	tracker.ObservePacket()
+	if count := tracker.counts[PacketTiming]; count != 1 {
+		t.Errorf("expected a single burst to be reported once, got %d", count)
+	}
+}
+
+func TestTurnRate(t *testing.T) {
+	tracker, _ := newTestTracker()
+
+	// Turning back and forth cancels out
+	for i := range 100 {
+		tracker.ObserveDirection(float64(i%2) * 3)
+	}
+	if count := tracker.counts[DirectionChangeRate]; count != 0 {
+		t.Fatalf("expected turning back and forth to be allowed, got %d reports", count)
+	}
+
+	// Turning 3 radians the same way at every update exceeds the rate after 9 updates
+	tracker, _ = newTestTracker()
+	for i := range 9 {
+		tracker.ObserveDirection(float64(i+1) * 3)
+	}
+	if count := tracker.counts[DirectionChangeRate]; count != 1 {
+		t.Errorf("expected spinning to be reported once, got %d", count)
+	}
+}
@@ -0,0 +1,141 @@
+package chatfilter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// A chat message on its way through the filters, which may change its text
+type Message struct {
+	Text string
+	Time time.Time
+}
+
+// A step of the pipeline, returns an error telling the sender why their message was rejected
+type Filter interface {
+	Filter(message *Message) error
+}
+
+// Runs chat messages through a list of filters in order, stopping at the first one to reject it.
+// Filters may keep state about earlier messages, so each client needs its own pipeline.
+type Pipeline struct {
+	filters []Filter
+}
+
+func NewPipeline(filters ...Filter) *Pipeline {
+	return &Pipeline{filters: filters}
+}
+
+// Run the text through every filter, returning the text to send or why it was rejected
+func (p *Pipeline) Run(text string) (string, error) {
+	message := &Message{Text: text, Time: time.Now()}
+	for _, filter := range p.filters {
+		if err := filter.Filter(message); err != nil {
+			return "", err
+		}
+	}
+	return message.Text, nil
+}
+
+// Rejects messages longer than the given number of characters
+type MaxLength int
+
+func (m MaxLength) Filter(message *Message) error {
+	if len([]rune(message.Text)) > int(m) {
+		return fmt.Errorf("your message is too long, the limit is %d characters", m)
+	}
+	if strings.TrimSpace(message.Text) == "" {
+		return fmt.Errorf("your message is empty")
+	}
+	return nil
+}
+
+// Replaces the words on a list with asterisks, ignoring case
+type WordMask struct {
+	pattern *regexp.Regexp
+}
+
+func NewWordMask(words []string) *WordMask {
+	quoted := make([]string, 0, len(words))
+	for _, word := range words {
+		if word = strings.TrimSpace(word); word != "" {
+			quoted = append(quoted, regexp.QuoteMeta(word))
+		}
+	}
+
+	if len(quoted) == 0 {
+		return &WordMask{}
+	}
+	return &WordMask{pattern: regexp.MustCompile(`(?i)\b(` + strings.Join(quoted, "|") + `)\b`)}
+}
+
+func (w *WordMask) Filter(message *Message) error {
+	if w.pattern != nil {
+		message.Text = w.pattern.ReplaceAllStringFunc(message.Text, func(word string) string {
+			return strings.Repeat("*", len([]rune(word)))
+		})
+	}
+	return nil
+}
+
+// Rejects a message if the sender already sent the same text within the window
+type Duplicates struct {
+	window time.Duration
+	sent   map[string]time.Time
+}
+
+func NewDuplicates(window time.Duration) *Duplicates {
+	return &Duplicates{
+		window: window,
+		sent:   make(map[string]time.Time),
+	}
+}
+
+func (d *Duplicates) Filter(message *Message) error {
+	for text, sentAt := range d.sent {
+		if message.Time.Sub(sentAt) > d.window {
+			delete(d.sent, text)
+		}
+	}
+
+	text := strings.ToLower(strings.Join(strings.Fields(message.Text), " "))
+	if _, exists := d.sent[text]; exists {
+		return fmt.Errorf("you already sent that message")
+	}
+
+	d.sent[text] = message.Time
+	return nil
+}
+
+// Rejects messages once the sender sent more than the given number within the window
+type Flood struct {
+	maxMessages int
+	window      time.Duration
+	sentAt      []time.Time
+}
+
+func NewFlood(maxMessages int, window time.Duration) *Flood {
+	return &Flood{
+		maxMessages: maxMessages,
+		window:      window,
+	}
+}
+
+func (f *Flood) Filter(message *Message) error {
+	recent := f.sentAt[:0]
+	for _, sentAt := range f.sentAt {
+		if message.Time.Sub(sentAt) < f.window {
+			recent = append(recent, sentAt)
+		}
+	}
+	f.sentAt = recent
+
+	if len(f.sentAt) >= f.maxMessages {
+		return fmt.Errorf("you are sending messages too quickly, slow down")
+	}
+
+	f.sentAt = append(f.sentAt, message.Time)
+	return nil
+}
@@ -0,0 +1,113 @@
+package chatfilter
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestPipeline() *Pipeline {
+	return NewPipeline(
+		MaxLength(20),
+		NewFlood(3, time.Minute),
+		NewDuplicates(time.Minute),
+		NewWordMask([]string{"darn", " heck ", ""}),
+	)
+}
+
+func TestPipeline(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+		ok   bool
+	}{
+		{"plain", "hello there", "hello there", true},
+		{"masked in any case", "Darn it", "**** it", true},
+		{"masked whole words only", "heckle the heck", "heckle the ****", true},
+		{"too long", strings.Repeat("a", 21), "", false},
+		{"longest in runes", strings.Repeat("é", 20), strings.Repeat("é", 20), true},
+		{"empty", "   ", "", false},
+	}
+
+	for _, test := range tests {
+		got, err := newTestPipeline().Run(test.text)
+		if test.ok && (err != nil || got != test.want) {
+			t.Errorf("%s: expected %q, got %q (%v)", test.name, test.want, got, err)
+		}
+		if !test.ok && err == nil {
+			t.Errorf("%s: expected it to be rejected, got %q", test.name, got)
+		}
+	}
+}
+
+func TestDuplicates(t *testing.T) {
+	p := newTestPipeline()
+	if _, err := p.Run("hello there"); err != nil {
+		t.Fatalf("expected the first message to be sent, got %v", err)
+	}
+	if _, err := p.Run("HELLO   there "); err == nil {
+		t.Error("expected the same text in another case and spacing to be rejected")
+	}
+
+	d := NewDuplicates(time.Minute)
+	d.Filter(&Message{Text: "hello", Time: time.Now().Add(-2 * time.Minute)})
+	if err := d.Filter(&Message{Text: "hello", Time: time.Now()}); err != nil {
+		t.Errorf("expected the text to be allowed again after the window, got %v", err)
+	}
+}
+
+func TestFlood(t *testing.T) {
+	p := newTestPipeline()
+	for i, text := range []string{"one", "two", "three"} {
+		if _, err := p.Run(text); err != nil {
+			t.Fatalf("expected message %d to be sent, got %v", i+1, err)
+		}
+	}
+	if _, err := p.Run("four"); err == nil {
+		t.Error("expected the fourth message within the window to be rejected")
+	}
+
+	f := NewFlood(1, time.Minute)
+	f.Filter(&Message{Text: "one", Time: time.Now().Add(-2 * time.Minute)})
+	if err := f.Filter(&Message{Text: "two", Time: time.Now()}); err != nil {
+		t.Errorf("expected a message to be allowed after the window, got %v", err)
+	}
+}
+
+func TestRejectedMessagesAreNotRemembered(t *testing.T) {
+	// The flood check comes before the duplicate check, so a message rejected for flooding can be sent later
+	p := NewPipeline(NewFlood(1, time.Minute), NewDuplicates(time.Minute))
+	p.Run("one")
+	if _, err := p.Run("two"); err == nil {
+		t.Fatal("expected the second message to be rejected")
+	}
+	if _, exists := p.filters[1].(*Duplicates).sent["two"]; exists {
+		t.Error("expected the rejected message not to reach the duplicate check")
+	}
+}
+
+func TestHistory(t *testing.T) {
+	h := NewHistory(3)
+	h.Record(1, "alice", "hi")
+	h.Record(2, "bob", "rude")
+	h.Record(1, "alice", "hey")
+	h.Record(2, "bob", "ok")
+
+	entry, conversation, found := h.Find(2, "rude")
+	if !found || entry.SenderName != "bob" {
+		t.Fatalf("expected to find bob's message, got %+v (found: %t)", entry, found)
+	}
+	if len(conversation) != 1 || conversation[0].Text != "rude" {
+		t.Errorf("expected the oldest message to have been dropped, got %+v", conversation)
+	}
+	if _, _, found := h.Find(1, "hi"); found {
+		t.Error("expected the dropped message not to be found")
+	}
+	if _, _, found := h.Find(1, "rude"); found {
+		t.Error("expected messages to be found by their sender only")
+	}
+	if lines := strings.Count(FormatContext(conversation), "\n"); lines != 0 {
+		t.Errorf("expected a single line, got %d line breaks", lines)
+	}
+}
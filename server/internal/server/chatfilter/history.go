@@ -0,0 +1,68 @@
+package chatfilter
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A chat message a client saw
+type Entry struct {
+	SenderId   uint64
+	SenderName string
+	Text       string
+	Time       time.Time
+}
+
+// The last chat messages a client saw, kept so a message they report can be stored together with the
+// conversation around it
+type History struct {
+	size    int
+	entries []Entry
+	mux     sync.Mutex
+}
+
+func NewHistory(size int) *History {
+	return &History{
+		size:    size,
+		entries: make([]Entry, 0, size),
+	}
+}
+
+func (h *History) Record(senderId uint64, senderName string, text string) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	if len(h.entries) == h.size {
+		h.entries = append(h.entries[:0], h.entries[1:]...)
+	}
+	h.entries = append(h.entries, Entry{
+		SenderId:   senderId,
+		SenderName: senderName,
+		Text:       text,
+		Time:       time.Now(),
+	})
+}
+
+// Find the latest message with the given text from the given sender, and the conversation up to it
+func (h *History) Find(senderId uint64, text string) (*Entry, []Entry, bool) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	for i := len(h.entries) - 1; i >= 0; i-- {
+		if entry := h.entries[i]; entry.SenderId == senderId && entry.Text == text {
+			return &entry, append([]Entry(nil), h.entries[:i+1]...), true
+		}
+	}
+	return nil, nil, false
+}
+
+// Format a conversation to be read by a moderator, one message per line
+func FormatContext(entries []Entry) string {
+	lines := make([]string, len(entries))
+	for i, entry := range entries {
+		lines[i] = fmt.Sprintf("[%s] %s (%d): %s", entry.Time.UTC().Format(time.TimeOnly), entry.SenderName, entry.SenderId, entry.Text)
+	}
+	return strings.Join(lines, "\n")
+}
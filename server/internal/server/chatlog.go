@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+	"server/internal/server/db"
+	"slices"
+	"sync"
+	"time"
+)
+
+// A public chat message as it's kept in the chat log
+type ChatLogEntry struct {
+	// The client id of the sender, 0 if the message was loaded from the database
+	SenderId   uint64
+	SenderName string
+	Msg        string
+	SentAt     time.Time
+}
+
+// The last public chat messages, so players who join late can catch up on the conversation.
+// Team and direct messages are private and aren't kept.
+type ChatLog struct {
+	size    int
+	entries []ChatLogEntry
+	// Where messages are stored so the history survives restarts, nil if it's only kept in memory
+	queries *db.Queries
+	mux     sync.Mutex
+}
+
+func NewChatLog(size int) *ChatLog {
+	return &ChatLog{
+		size:    size,
+		entries: make([]ChatLogEntry, 0, size),
+	}
+}
+
+// Store every message in the database from now on, and load the messages from before the server started
+func (l *ChatLog) Persist(ctx context.Context, queries *db.Queries) error {
+	messages, err := queries.ListRecentChatMessages(ctx, int64(l.size))
+	if err != nil {
+		return err
+	}
+
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	l.queries = queries
+	l.entries = l.entries[:0]
+	// The messages come newest first
+	for _, message := range slices.Backward(messages) {
+		l.entries = append(l.entries, ChatLogEntry{
+			SenderName: message.SenderName,
+			Msg:        message.Message,
+			SentAt:     message.CreatedAt,
+		})
+	}
+	return nil
+}
+
+// Add a message to the log, it still has to be stored if the log is persisted
+func (l *ChatLog) Add(senderId uint64, senderName string, msg string) ChatLogEntry {
+	entry := ChatLogEntry{
+		SenderId:   senderId,
+		SenderName: senderName,
+		Msg:        msg,
+		SentAt:     time.Now(),
+	}
+	if l.size <= 0 {
+		return entry
+	}
+
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	if len(l.entries) == l.size {
+		l.entries = append(l.entries[:0], l.entries[1:]...)
+	}
+	l.entries = append(l.entries, entry)
+	return entry
+}
+
+// Store a message which was added to the log in the database, unless the log is only kept in memory. It may take a
+// while, so it's best left to a worker.
+func (l *ChatLog) Store(ctx context.Context, entry ChatLogEntry) error {
+	l.mux.Lock()
+	queries := l.queries
+	l.mux.Unlock()
+
+	if queries == nil || l.size <= 0 {
+		return nil
+	}
+
+	return queries.CreateChatMessage(ctx, db.CreateChatMessageParams{
+		SenderName: entry.SenderName,
+		Message:    entry.Msg,
+		CreatedAt:  entry.SentAt.UTC(),
+	})
+}
+
+// Get the messages in the log, oldest first
+func (l *ChatLog) Recent() []ChatLogEntry {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	return slices.Clone(l.entries)
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+)
+
+func messagesOf(entries []ChatLogEntry) []string {
+	messages := make([]string, len(entries))
+	for i, entry := range entries {
+		messages[i] = entry.Msg
+	}
+	return messages
+}
+
+func TestChatLogWrapsAround(t *testing.T) {
+	tests := []struct {
+		name  string
+		size  int
+		added int
+		want  []string
+	}{
+		{"empty", 3, 0, []string{}},
+		{"not full", 3, 2, []string{"1", "2"}},
+		{"full", 3, 3, []string{"1", "2", "3"}},
+		{"wrapped", 3, 5, []string{"3", "4", "5"}},
+		{"wrapped twice", 3, 7, []string{"5", "6", "7"}},
+		{"disabled", 0, 2, []string{}},
+	}
+
+	for _, test := range tests {
+		chat := NewChatLog(test.size)
+		for i := 1; i <= test.added; i++ {
+			chat.Add(uint64(i), "bob", fmt.Sprint(i))
+		}
+		if got := messagesOf(chat.Recent()); fmt.Sprint(got) != fmt.Sprint(test.want) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.want, got)
+		}
+	}
+}
+
+func TestChatLogRecentIsACopy(t *testing.T) {
+	chat := NewChatLog(2)
+	chat.Add(1, "bob", "hi")
+	recent := chat.Recent()
+	chat.Add(1, "bob", "hello")
+	chat.Add(1, "bob", "hey")
+
+	if recent[0].Msg != "hi" {
+		t.Errorf("expected the earlier messages not to change, got %q", recent[0].Msg)
+	}
+}
+
+func TestChatLogPersist(t *testing.T) {
+	config := DefaultConfig()
+	config.DbPath = InMemoryDb
+	dbTx := NewHub(config).NewDbTx()
+
+	chat := NewChatLog(2)
+	if err := chat.Persist(dbTx.Ctx, dbTx.Queries); err != nil {
+		t.Fatal(err)
+	}
+	for _, msg := range []string{"one", "two", "three"} {
+		if err := chat.Store(dbTx.Ctx, chat.Add(1, "bob", msg)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A restarted server picks up the last messages, oldest first
+	restarted := NewChatLog(2)
+	if err := restarted.Persist(dbTx.Ctx, dbTx.Queries); err != nil {
+		t.Fatal(err)
+	}
+	if got := messagesOf(restarted.Recent()); fmt.Sprint(got) != "[two three]" {
+		t.Errorf("expected [two three], got %v", got)
+	}
+}
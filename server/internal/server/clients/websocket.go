@@ -1,25 +1,62 @@
 package clients
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"log"
+	"math"
 	"net/http"
 	"server/internal/server"
+	"server/internal/server/anticheat"
+	"server/internal/server/chatfilter"
+	"server/internal/server/db"
+	"server/internal/server/loginguard"
+	"server/internal/server/moderation"
+	"server/internal/server/names"
+	"server/internal/server/rating"
 	"server/internal/server/states"
+	"server/internal/server/validation"
 	"server/pkg/packets"
+	"sync/atomic"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"google.golang.org/protobuf/proto"
 )
 
+// How many of the last chat messages a client received are kept as context for reports
+const chatHistorySize = 20
+
+// How many messages, like the results of jobs, may wait for the client's goroutine
+const queueSize = 64
+
 type WebSocketClient struct {
 	id       uint64
 	conn     *websocket.Conn
 	sendChan chan *packets.Packet
-	hub      *server.Hub
-	state    server.ClientStateHandler
-	dbTx     *server.DbTx
-	logger   *log.Logger
+	// Messages from other goroutines, handled by the read pump between the client's packets
+	queue chan server.LocalMsg
+	// Closed once the client is, so nothing is queued for it anymore
+	closed chan struct{}
+	hub    *server.Hub
+	state  server.ClientStateHandler
+	// The state again, for other goroutines to read
+	sharedState atomic.Pointer[server.ClientStateHandler]
+	dbTx        *server.DbTx
+	validator   *validation.Validator
+	antiCheat   *anticheat.Tracker
+	// Filters keep track of the messages sent before, so every client gets its own pipeline
+	chatFilter  *chatfilter.Pipeline
+	chatHistory *chatfilter.History
+	muteStatus  moderation.MuteStatus
+	ip          string
+	logger      *log.Logger
+	// The room the client is playing in, or last played in
+	room atomic.Pointer[server.Room]
+	// The bits of the float64 rating, so the matchmaker can read it while the client changes it
+	rating atomic.Uint64
 }
 
 func NewWebSocketClient(hub *server.Hub, writer http.ResponseWriter, request *http.Request) (server.ClientInterfacer, error) {
@@ -36,12 +73,25 @@ func NewWebSocketClient(hub *server.Hub, writer http.ResponseWriter, request *ht
 	}
 
 	c := &WebSocketClient{
-		conn:     conn,
-		sendChan: make(chan *packets.Packet, 256),
-		hub:      hub,
-		dbTx:     hub.NewDbTx(),
-		logger:   log.New(log.Writer(), "Client unknown: ", log.LstdFlags),
+		conn:      conn,
+		sendChan:  make(chan *packets.Packet, 256),
+		queue:     make(chan server.LocalMsg, queueSize),
+		closed:    make(chan struct{}),
+		hub:       hub,
+		dbTx:      hub.NewDbTx(),
+		validator: validation.NewValidator(),
+		chatFilter: chatfilter.NewPipeline(
+			chatfilter.MaxLength(hub.Config.ChatMaxLength),
+			chatfilter.NewFlood(hub.Config.ChatFloodMessages, hub.Config.ChatFloodWindow),
+			chatfilter.NewDuplicates(hub.Config.ChatDuplicateWindow),
+			chatfilter.NewWordMask(hub.Config.ChatBlockedWords),
+		),
+		chatHistory: chatfilter.NewHistory(chatHistorySize),
+		ip:          server.RemoteIP(request),
+		logger:      log.New(log.Writer(), "Client unknown: ", log.LstdFlags),
 	}
+	c.antiCheat = anticheat.NewTracker(c.logger, c.handleOffense)
+	c.SetRating(rating.Initial)
 
 	return c, nil
 }
@@ -51,11 +101,36 @@ func (c *WebSocketClient) Id() uint64 {
 }
 
 func (c *WebSocketClient) SetState(state server.ClientStateHandler) {
+	c.changeState(state, func() {
+		// Players who log out leave their room
+		if _, playing := state.(server.UserStateHandler); !playing {
+			if room := c.room.Load(); room != nil {
+				room.Remove(c.id)
+			}
+		}
+	})
+}
+
+func (c *WebSocketClient) JoinRoom(room *server.Room, state server.ClientStateHandler) {
+	c.changeState(state, func() {
+		if current := c.room.Load(); current != nil {
+			current.Remove(c.id)
+		}
+		room.Add(c)
+		c.room.Store(room)
+		c.logger.Printf("Joined room %s", room.Name)
+	})
+}
+
+// Leave the current state, call move to put the client in the room the new state belongs to, then enter it. The old
+// state cleans up in the room it was in.
+func (c *WebSocketClient) changeState(state server.ClientStateHandler, move func()) {
 	prevStateName := "None "
 	if c.state != nil {
 		prevStateName = c.state.Name()
 		c.state.OnExit()
 	}
+	move()
 
 	newStateName := "None"
 	if state != nil {
@@ -64,16 +139,58 @@ func (c *WebSocketClient) SetState(state server.ClientStateHandler) {
 
 	c.logger.Printf("Changing state from %s to %s", prevStateName, newStateName)
 	c.state = state
+	c.sharedState.Store(&state)
 	if c.state != nil {
 		c.state.SetClient(c)
 		c.state.OnEnter()
 	}
+	c.hub.UpdatePresence(c, state)
+	// Players who log out leave their party
+	if _, playing := state.(server.UserStateHandler); !playing {
+		c.hub.LeaveParty(c)
+	}
 }
 
 func (c *WebSocketClient) ProcessMessage(senderId uint64, message packets.Msg) {
+	// Jobs may finish after the client was closed
+	if c.state == nil {
+		return
+	}
 	c.state.HandleMessage(senderId, message)
 }
 
+func (c *WebSocketClient) Queue(message server.LocalMsg) {
+	select {
+	case c.queue <- message:
+	case <-c.closed:
+	default:
+		c.logger.Printf("Queue full, dropping message: %T", message)
+	}
+}
+
+func (c *WebSocketClient) State() server.ClientStateHandler {
+	if state := c.sharedState.Load(); state != nil {
+		return *state
+	}
+	return nil
+}
+
+// Handle a message another goroutine queued, tasks only if the state which queued them is still the current one
+func (c *WebSocketClient) processQueued(message server.LocalMsg) {
+	switch message := message.(type) {
+	case *server.StateTask:
+		if c.state == nil || message.State != c.state {
+			c.logger.Printf("Dropping a task queued by another state")
+			return
+		}
+		message.Run()
+	default:
+		if handler, ok := c.state.(server.LocalMsgHandler); ok {
+			handler.HandleLocal(message)
+		}
+	}
+}
+
 func (c *WebSocketClient) Initialize(id uint64) {
 	c.id = id
 	c.logger.SetPrefix(fmt.Sprintf("Client %d: ", c.id))
@@ -104,24 +221,74 @@ func (c *WebSocketClient) PassToPeer(message packets.Msg, peerId uint64) {
 }
 
 func (c *WebSocketClient) Broadcast(message packets.Msg) {
+	// Nobody has seen a client which never played
+	room := c.room.Load()
+	if room == nil {
+		return
+	}
+	room.Broadcast(&packets.Packet{
+		SenderId: c.id,
+		Msg:      message,
+	})
+}
+
+func (c *WebSocketClient) BroadcastAll(message packets.Msg) {
 	c.hub.BroadcastChan <- &packets.Packet{
 		SenderId: c.id,
 		Msg:      message,
 	}
 }
+
+func (c *WebSocketClient) SystemBroadcast(message packets.Msg) {
+	c.hub.BroadcastChan <- &packets.Packet{
+		SenderId: 0,
+		Msg:      message,
+	}
+}
+
+func (c *WebSocketClient) Peer(peerId uint64) (server.ClientInterfacer, bool) {
+	return c.hub.Clients.Get(peerId)
+}
+
+// Process the packets the client sends and the messages queued for it, one at a time, until the connection closes
 func (c *WebSocketClient) ReadPump() {
+	closeReason := "Read pump stopped"
 	defer func() {
 		c.logger.Println("Read pump stopped")
-		c.Close("Read pump stopped")
+		c.Close(closeReason)
 	}()
 
+	incoming := make(chan *packets.Packet)
+	go c.readPackets(incoming)
+
+	for {
+		select {
+		case packet, open := <-incoming:
+			if !open {
+				return
+			}
+			if reason := c.processPacket(packet); reason != "" {
+				closeReason = reason
+				c.sendCloseFrame(closeReason)
+				return
+			}
+		case message := <-c.queue:
+			c.processQueued(message)
+		}
+	}
+}
+
+// Read packets from the socket and hand them to the read pump, until the connection closes
+func (c *WebSocketClient) readPackets(incoming chan<- *packets.Packet) {
+	defer close(incoming)
+
 	for {
 		_, data, err := c.conn.ReadMessage()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 				c.logger.Printf("Error reading message: %v", err)
 			}
-			break
+			return
 		}
 
 		packet := &packets.Packet{}
@@ -131,19 +298,51 @@ func (c *WebSocketClient) ReadPump() {
 			continue
 		}
 
-		// To allow the client to lazily not set the sender ID, we'll assume they want to send it as themselves
-		if packet.SenderId == 0 {
-			packet.SenderId = c.id
+		select {
+		case incoming <- packet:
+		case <-c.closed:
+			return
 		}
+	}
+}
+
+// Validate and process a packet the client sent, returns why the client has to be closed, if it has to
+func (c *WebSocketClient) processPacket(packet *packets.Packet) string {
+	// Clients can only ever send packets as themselves
+	packet.SenderId = c.id
+
+	if err := c.validator.Validate(packet); errors.Is(err, validation.ErrThrottled) {
+		return ""
+	} else if err != nil {
+		c.logger.Printf("Rejected %T packet: %v", packet.Msg, err)
+		if c.validator.RepeatOffender() {
+			return "Too many invalid packets"
+		}
+		return ""
+	}
 
-		c.ProcessMessage(packet.SenderId, packet.Msg)
+	// Bursts of gameplay packets are normal, e.g. spores pulled in by a magnet are all reported at once
+	if !validation.DropOnly(packet.Msg) {
+		c.antiCheat.ObservePacket()
+	}
+
+	c.ProcessMessage(packet.SenderId, packet.Msg)
+	return ""
+}
+
+// Let the other end know why we're about to close the connection
+func (c *WebSocketClient) sendCloseFrame(reason string) {
+	message := websocket.FormatCloseMessage(websocket.ClosePolicyViolation, reason)
+	if err := c.conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(time.Second)); err != nil {
+		c.logger.Printf("Error sending close frame: %v", err)
 	}
 }
 
 func (c *WebSocketClient) WritePump() {
 	defer func() {
 		c.logger.Println("Write pump stopped")
-		c.Close("Write pump stopped")
+		// Closing the connection stops the read pump, which cleans up the client on its own goroutine
+		c.conn.Close()
 	}()
 
 	for packet := range c.sendChan {
@@ -174,19 +373,117 @@ func (c *WebSocketClient) WritePump() {
 }
 
 func (c *WebSocketClient) SharedGameObjects() *server.SharedGameObjects {
-	return c.hub.SharedGameObjects
+	if room := c.room.Load(); room != nil {
+		return room.SharedGameObjects
+	}
+	return nil
+}
+
+func (c *WebSocketClient) Room() *server.Room {
+	return c.room.Load()
+}
+
+func (c *WebSocketClient) Rating() float64 {
+	return math.Float64frombits(c.rating.Load())
+}
+
+func (c *WebSocketClient) SetRating(rating float64) {
+	c.rating.Store(math.Float64bits(rating))
 }
 
 func (c *WebSocketClient) DbTx() *server.DbTx {
 	return c.dbTx
 }
 
+func (c *WebSocketClient) AntiCheat() *anticheat.Tracker {
+	return c.antiCheat
+}
+
+// Store offenders in the database so repeat offenders can be looked into, and kick those who crossed the kick score.
+// The score is raised on whichever goroutine noticed the violation, so the client is kicked from there.
+func (c *WebSocketClient) handleOffense(offense anticheat.Offense) {
+	params := db.CreateCheatOffenseParams{
+		UserID:     sql.NullInt64{Int64: offense.UserId, Valid: offense.UserId != 0},
+		PlayerName: offense.PlayerName,
+		Score:      offense.Score,
+		Action:     string(offense.Action),
+		Details:    offense.Details,
+	}
+	queries, logger := c.dbTx.Queries, c.logger
+	err := c.Workers().Submit(c, func(ctx context.Context) error {
+		if err := queries.CreateCheatOffense(ctx, params); err != nil {
+			logger.Printf("Failed to persist anti-cheat offense: %v", err)
+		}
+		return nil
+	}, nil)
+	if err != nil {
+		c.logger.Printf("Failed to persist anti-cheat offense: %v", err)
+	}
+
+	if offense.Action == anticheat.ActionKick {
+		c.Kick("Kicked for suspicious behaviour")
+	}
+}
+
+func (c *WebSocketClient) ChatFilter() *chatfilter.Pipeline {
+	return c.chatFilter
+}
+
+func (c *WebSocketClient) ChatHistory() *chatfilter.History {
+	return c.chatHistory
+}
+
+func (c *WebSocketClient) MuteStatus() *moderation.MuteStatus {
+	return &c.muteStatus
+}
+
+func (c *WebSocketClient) LoginGuard() *loginguard.Guard {
+	return c.hub.LoginGuard
+}
+
+func (c *WebSocketClient) Names() *names.Registry {
+	return c.hub.Names
+}
+
+func (c *WebSocketClient) Presence() *server.Presence {
+	return c.hub.Presence
+}
+
+func (c *WebSocketClient) Parties() *server.Parties {
+	return c.hub.Parties
+}
+
+func (c *WebSocketClient) Rooms() *server.Rooms {
+	return c.hub.Rooms
+}
+
+func (c *WebSocketClient) Workers() *server.WorkerPool {
+	return c.hub.Workers
+}
+
+// Closing the connection stops the read pump, which cleans up the client
+func (c *WebSocketClient) Kick(reason string) {
+	c.logger.Printf("Kicking client because: %s", reason)
+	c.sendCloseFrame(reason)
+	c.conn.Close()
+}
+
+func (c *WebSocketClient) IP() string {
+	return c.ip
+}
+
+func (c *WebSocketClient) Config() server.Config {
+	return c.hub.Config
+}
+
 func (c *WebSocketClient) Close(reason string) {
 	c.logger.Printf("Closing client connection because: %s", reason)
 
 	c.Broadcast(packets.NewDisconnect(reason))
 
 	c.SetState(nil)
+	close(c.closed)
+	c.hub.Names.Release(c.id)
 	c.hub.UnregisterChan <- c
 	c.conn.Close()
 	if _, closed := <-c.sendChan; !closed {
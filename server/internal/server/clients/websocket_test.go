@@ -0,0 +1,94 @@
+package clients
+
+import (
+	"context"
+	"io"
+	"log"
+	"server/internal/server"
+	"server/internal/server/anticheat"
+	"server/internal/server/validation"
+	"server/pkg/packets"
+	"testing"
+	"time"
+)
+
+// A magnet pulls in a few hundred spores at once, which the game client all reports straight away while it keeps
+// steering. None of it may count against the player.
+func TestMagnetSporeBurstIsNotKicked(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+	c := &WebSocketClient{
+		validator: validation.NewValidator(),
+		antiCheat: anticheat.NewTracker(logger, nil),
+		logger:    logger,
+	}
+
+	// Enough bursts, each in a window of its own, to get the player kicked if every one counted
+	const bursts, burstSize = 22, 40
+	sporeId := uint64(0)
+	for i := range bursts {
+		for range burstSize {
+			sporeId++
+			burst := []packets.Msg{
+				&packets.Packet_SporeConsumed{SporeConsumed: &packets.SporeConsumedMessage{SporeId: sporeId}},
+				&packets.Packet_PlayerDirection{PlayerDirection: &packets.PlayerDirectionMessage{Direction: float64(sporeId)}},
+			}
+			for _, msg := range burst {
+				if reason := c.processPacket(&packets.Packet{Msg: msg}); reason != "" {
+					t.Fatalf("closed during burst %d: %s", i, reason)
+				}
+			}
+		}
+		time.Sleep(110 * time.Millisecond)
+	}
+
+	if c.antiCheat.Flagged() {
+		t.Error("the burst got the player flagged")
+	}
+	if c.validator.RepeatOffender() {
+		t.Error("the burst made the player a repeat offender")
+	}
+}
+
+type testState struct{ name string }
+
+func (s *testState) Name() string                                   { return s.name }
+func (s *testState) SetClient(client server.ClientInterfacer)       {}
+func (s *testState) OnEnter()                                       {}
+func (s *testState) HandleMessage(senderId uint64, msg packets.Msg) {}
+func (s *testState) OnExit()                                        {}
+
+// Jobs finish on a worker, after which the client may have moved on, e.g. logged out while its password was checked
+func TestJobCallbackDroppedAfterStateChange(t *testing.T) {
+	c := &WebSocketClient{
+		queue:  make(chan server.LocalMsg, queueSize),
+		closed: make(chan struct{}),
+		logger: log.New(io.Discard, "", 0),
+	}
+	setState := func(state server.ClientStateHandler) {
+		c.state = state
+		c.sharedState.Store(&state)
+	}
+	workers := server.NewWorkerPool(1, 1, time.Second)
+
+	for _, changed := range []bool{false, true} {
+		setState(&testState{name: "Before"})
+		called := false
+		err := workers.Submit(c, func(ctx context.Context) error { return nil }, func(err error) { called = true })
+		if err != nil {
+			t.Fatal(err)
+		}
+		if changed {
+			setState(&testState{name: "After"})
+		}
+
+		select {
+		case message := <-c.queue:
+			c.processQueued(message)
+		case <-time.After(time.Second):
+			t.Fatal("expected the job's callback to be queued")
+		}
+		if called == changed {
+			t.Errorf("state changed: %t, expected the callback to be called: %t", changed, !changed)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"server/internal/server/achievements"
+	"time"
+)
+
+const (
+	// Players spawn forever in one endless world
+	ModeEndless = "endless"
+	// Players compete in timed rounds inside a shrinking safe zone until one is left
+	ModeRoyale = "royale"
+)
+
+// The database path which keeps everything in memory, for tests and throwaway servers
+const InMemoryDb = ":memory:"
+
+// Settings which may differ between the rooms of one server
+type RoomConfig struct {
+	Name string
+	// Number of teams players are split into, 0 means everyone plays for themselves
+	Teams int
+}
+
+// Settings for the hub and the game world it runs
+type Config struct {
+	// Number of teams in the first room and in the rooms opened when the others fill up, 0 means everyone plays for
+	// themselves
+	Teams int
+	// Either ModeEndless or ModeRoyale
+	Mode string
+
+	// How long players wait in the lobby before a round starts
+	LobbyDuration time.Duration
+	// How long a round lasts at most
+	RoundDuration time.Duration
+	// How long the winner is announced before the next lobby opens
+	RoundEndDuration time.Duration
+	// The number of players needed to start a round
+	MinRoundPlayers int
+	// Radius of the safe zone when a round starts and when time runs out
+	ZoneStartRadius float64
+	ZoneEndRadius   float64
+	// Mass drained per second from players outside the safe zone
+	ZoneDrainRate float64
+
+	// How often a new power-up is spawned, up to MaxPowerUps in the world at once
+	PowerUpSpawnRate time.Duration
+	MaxPowerUps      int
+	// How long a power-up's effect lasts after it's picked up
+	PowerUpDuration time.Duration
+
+	// How long a consumed player has to wait before they can respawn
+	RespawnCooldown time.Duration
+
+	// The longest chat message a player may send, in characters
+	ChatMaxLength int
+	// Words replaced with asterisks in chat messages
+	ChatBlockedWords []string
+	// How long a player has to wait before sending the same chat message again
+	ChatDuplicateWindow time.Duration
+	// A player may send at most ChatFloodMessages chat messages within ChatFloodWindow
+	ChatFloodMessages int
+	ChatFloodWindow   time.Duration
+	// How many public chat messages players see when they join
+	ChatHistorySize int
+	// Whether public chat messages are stored in the database, so the history survives restarts
+	PersistChat bool
+
+	// The most players a party can have
+	MaxPartySize int
+
+	// The achievements registered users can unlock
+	Achievements []achievements.Definition
+
+	// The name of the first room, which is always open. Rooms opened when it fills up are named after it.
+	Room string
+	// More rooms which are always open, each with settings of its own, e.g. a team room next to a free-for-all first
+	// room. They're in the first region.
+	Rooms []RoomConfig
+	// The latency regions players can ask to play in, the first room is in the first of them. Empty if the server
+	// doesn't tell regions apart.
+	Regions []string
+	// The most players a room takes, and the most rooms which may be open at once
+	RoomCapacity int
+	MaxRooms     int
+	// How long a room opened when the others filled up stays open once its last player left
+	RoomIdleTimeout time.Duration
+
+	// How many workers run database queries and password hashing for clients, how many jobs may wait for them, and
+	// how long a job may take including the wait
+	Workers          int
+	WorkerQueueSize  int
+	WorkerJobTimeout time.Duration
+
+	// The SQLite database file, or InMemoryDb to keep everything in memory
+	DbPath string
+	// PostgreSQL connection string of the database user accounts are kept in, so several servers can share them.
+	// Empty to keep them in the local database.
+	AccountsDb string
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Teams:            0,
+		Mode:             ModeEndless,
+		LobbyDuration:    30 * time.Second,
+		RoundDuration:    5 * time.Minute,
+		RoundEndDuration: 10 * time.Second,
+		MinRoundPlayers:  2,
+		ZoneStartRadius:  3000,
+		ZoneEndRadius:    200,
+		ZoneDrainRate:    150,
+		PowerUpSpawnRate: 10 * time.Second,
+		MaxPowerUps:      20,
+		PowerUpDuration:  10 * time.Second,
+		RespawnCooldown:  3 * time.Second,
+
+		ChatMaxLength:       200,
+		ChatDuplicateWindow: 30 * time.Second,
+		ChatFloodMessages:   5,
+		ChatFloodWindow:     10 * time.Second,
+		ChatHistorySize:     50,
+		PersistChat:         true,
+
+		MaxPartySize: 4,
+		Achievements: achievements.Defaults(),
+
+		Room:            "main",
+		RoomCapacity:    30,
+		MaxRooms:        8,
+		RoomIdleTimeout: 2 * time.Minute,
+
+		Workers:          8,
+		WorkerQueueSize:  256,
+		WorkerJobTimeout: 10 * time.Second,
+
+		DbPath: "db.sqlite",
+	}
+}
@@ -0,0 +1,5 @@
+-- Changes to tables which already exist in older databases. Every migration starts with a "-- migration N" line,
+-- numbered from 1 in the order they are applied, and runs once: the database's user_version is the last one applied.
+
+-- migration 1
+ALTER TABLE users ADD COLUMN role TEXT NOT NULL DEFAULT 'player';
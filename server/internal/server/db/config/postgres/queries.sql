@@ -0,0 +1,200 @@
+-- name: GetUserByID :one
+SELECT * FROM users
+WHERE id = $1 LIMIT 1;
+
+-- name: GetUserByUsername :one
+SELECT * FROM users
+WHERE username = $1 LIMIT 1;
+
+-- name: CreateUser :one
+INSERT INTO users (
+    username, password_hash
+) VALUES (
+    $1, $2
+)
+RETURNING *;
+
+-- name: UpdateUserPassword :exec
+UPDATE users SET password_hash = $1
+WHERE id = $2;
+
+-- name: SetUserRole :execrows
+UPDATE users SET role = $1
+WHERE id = $2;
+
+-- name: DeleteUser :exec
+DELETE FROM users
+WHERE id = $1;
+
+-- name: GetUserProfile :one
+SELECT * FROM user_profiles
+WHERE user_id = $1 LIMIT 1;
+
+-- name: GetUserProfileByDisplayName :one
+SELECT * FROM user_profiles
+WHERE lower(display_name) = sqlc.arg(display_name) AND user_id != sqlc.arg(user_id)
+LIMIT 1;
+
+-- name: UpsertUserProfile :one
+INSERT INTO user_profiles (
+    user_id, color, display_name, skin_id, settings
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+ON CONFLICT (user_id) DO UPDATE SET
+    color = excluded.color,
+    display_name = excluded.display_name,
+    skin_id = excluded.skin_id,
+    settings = excluded.settings,
+    updated_at = now()
+RETURNING *;
+
+-- name: DeleteUserProfile :exec
+DELETE FROM user_profiles
+WHERE user_id = $1;
+
+-- name: CreatePasswordReset :exec
+INSERT INTO password_resets (
+    user_id, code_hash, expires_at
+) VALUES (
+    $1, $2, $3
+);
+
+-- name: ListPasswordResetsByUser :many
+SELECT * FROM password_resets
+WHERE user_id = $1
+ORDER BY id;
+
+-- name: DeletePasswordResetsByUser :exec
+DELETE FROM password_resets
+WHERE user_id = $1;
+
+-- name: CreateSession :exec
+INSERT INTO sessions (
+    user_id, player_name, room, started_at, ended_at, end_cause, killer_name, peak_mass, spores_eaten, players_eaten
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+);
+
+-- name: ListRecentSessionsByUser :many
+SELECT * FROM sessions
+WHERE user_id = $1
+ORDER BY id DESC
+LIMIT $2;
+
+-- name: ListTopKillSessions :many
+SELECT * FROM sessions
+WHERE ended_at >= $1
+ORDER BY players_eaten DESC, peak_mass DESC
+LIMIT $2;
+
+-- name: DeleteSessionsByUser :exec
+DELETE FROM sessions
+WHERE user_id = $1;
+
+-- name: GetSessionTotalsByUser :one
+SELECT
+    CAST(COALESCE(SUM(spores_eaten), 0) AS BIGINT) AS spores_eaten,
+    CAST(COALESCE(SUM(players_eaten), 0) AS BIGINT) AS players_eaten
+FROM sessions
+WHERE user_id = $1;
+
+-- name: UnlockAchievement :exec
+INSERT INTO user_achievements (
+    user_id, achievement_id, unlocked_at
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (user_id, achievement_id) DO NOTHING;
+
+-- name: ListAchievementsByUser :many
+SELECT * FROM user_achievements
+WHERE user_id = $1
+ORDER BY unlocked_at;
+
+-- name: DeleteAchievementsByUser :exec
+DELETE FROM user_achievements
+WHERE user_id = $1;
+
+-- name: CreateFriendship :exec
+INSERT INTO friendships (
+    user_id, friend_id, status
+) VALUES (
+    $1, $2, $3
+);
+
+-- name: GetFriendship :one
+SELECT * FROM friendships
+WHERE user_id = $1 AND friend_id = $2 LIMIT 1;
+
+-- name: AcceptFriendship :execrows
+UPDATE friendships SET status = 'accepted'
+WHERE user_id = $1 AND friend_id = $2 AND status = 'pending';
+
+-- name: ListFriendsByUser :many
+SELECT friendships.friend_id, users.username, friendships.status
+FROM friendships
+JOIN users ON users.id = friendships.friend_id
+WHERE friendships.user_id = $1
+ORDER BY users.username;
+
+-- name: ListFriendRequestsToUser :many
+SELECT friendships.user_id, users.username
+FROM friendships
+JOIN users ON users.id = friendships.user_id
+WHERE friendships.friend_id = $1 AND friendships.status = 'pending'
+ORDER BY users.username;
+
+-- name: DeleteFriendship :exec
+DELETE FROM friendships
+WHERE user_id = $1 AND friend_id = $2;
+
+-- name: DeleteFriendshipsByUser :exec
+DELETE FROM friendships
+WHERE user_id = $1 OR friend_id = $2;
+
+-- name: GetUserRating :one
+SELECT * FROM user_ratings
+WHERE user_id = $1 LIMIT 1;
+
+-- name: DeleteUserRating :exec
+DELETE FROM user_ratings
+WHERE user_id = $1;
+
+-- name: UpsertUserRating :exec
+INSERT INTO user_ratings (
+    user_id, rating
+) VALUES (
+    $1, $2
+)
+ON CONFLICT (user_id) DO UPDATE SET
+    rating = excluded.rating,
+    updated_at = CURRENT_TIMESTAMP;
+
+-- name: CountUsersRatedAbove :one
+SELECT COUNT(*) FROM user_ratings
+WHERE rating > $1;
+
+-- name: ListTopRatings :many
+SELECT users.username, user_ratings.rating
+FROM user_ratings
+JOIN users ON users.id = user_ratings.user_id
+ORDER BY user_ratings.rating DESC, users.username
+LIMIT $1;
+
+-- name: CreateRatingChange :exec
+INSERT INTO rating_history (
+    user_id, rating, delta, placement, players, kills
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
+
+-- name: ListRatingHistoryByUser :many
+SELECT * FROM rating_history
+WHERE user_id = $1
+ORDER BY id DESC
+LIMIT $2;
+
+-- name: DeleteRatingHistoryByUser :exec
+DELETE FROM rating_history
+WHERE user_id = $1;
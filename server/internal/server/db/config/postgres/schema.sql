@@ -0,0 +1,77 @@
+CREATE TABLE IF NOT EXISTS users (
+    id BIGSERIAL PRIMARY KEY,
+    username TEXT NOT NULL UNIQUE,
+    password_hash TEXT NOT NULL,
+    role TEXT NOT NULL DEFAULT 'player'
+);
+
+CREATE TABLE IF NOT EXISTS user_profiles (
+    user_id BIGINT PRIMARY KEY REFERENCES users(id),
+    color INTEGER NOT NULL DEFAULT 0,
+    display_name TEXT NOT NULL DEFAULT '',
+    skin_id INTEGER NOT NULL DEFAULT 0,
+    settings BYTEA NOT NULL DEFAULT '',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS password_resets (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id),
+    code_hash TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS sessions (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT REFERENCES users(id),
+    player_name TEXT NOT NULL,
+    room TEXT NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    ended_at TIMESTAMPTZ NOT NULL,
+    end_cause TEXT NOT NULL,
+    killer_name TEXT NOT NULL,
+    peak_mass DOUBLE PRECISION NOT NULL,
+    spores_eaten BIGINT NOT NULL,
+    players_eaten BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS sessions_user_id ON sessions (user_id);
+CREATE INDEX IF NOT EXISTS sessions_ended_at ON sessions (ended_at);
+
+CREATE TABLE IF NOT EXISTS user_achievements (
+    user_id BIGINT NOT NULL REFERENCES users(id),
+    achievement_id TEXT NOT NULL,
+    unlocked_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, achievement_id)
+);
+
+CREATE TABLE IF NOT EXISTS friendships (
+    user_id BIGINT NOT NULL REFERENCES users(id),
+    friend_id BIGINT NOT NULL REFERENCES users(id),
+    status TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, friend_id)
+);
+
+CREATE INDEX IF NOT EXISTS friendships_friend_id ON friendships (friend_id);
+
+CREATE TABLE IF NOT EXISTS user_ratings (
+    user_id BIGINT PRIMARY KEY REFERENCES users(id),
+    rating DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS rating_history (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id),
+    rating DOUBLE PRECISION NOT NULL,
+    delta DOUBLE PRECISION NOT NULL,
+    placement BIGINT NOT NULL,
+    players BIGINT NOT NULL,
+    kills BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS rating_history_user_id ON rating_history (user_id);
+CREATE INDEX IF NOT EXISTS user_ratings_rating ON user_ratings (rating);
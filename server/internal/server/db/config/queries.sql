@@ -8,4 +8,297 @@ INSERT INTO users (
 ) VALUES (
     ?, ?
 )
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+
+-- name: CreateCheatOffense :exec
+INSERT INTO cheat_offenses (
+    user_id, player_name, score, action, details
+) VALUES (
+    ?, ?, ?, ?, ?
+);
+
+-- name: UnlinkCheatOffensesFromUser :exec
+UPDATE cheat_offenses SET user_id = NULL
+WHERE user_id = ?;
+
+-- name: CreateBan :one
+INSERT INTO bans (
+    user_id, username, ip_cidr, reason, issued_by, expires_at
+) VALUES (
+    ?, ?, ?, ?, ?, ?
+)
+RETURNING *;
+
+-- name: DeleteBan :execrows
+DELETE FROM bans
+WHERE id = ?;
+
+-- name: ListBans :many
+SELECT * FROM bans
+ORDER BY id;
+
+-- name: UnlinkBansFromUser :exec
+UPDATE bans SET user_id = NULL
+WHERE user_id = ?;
+
+-- name: ListActiveBansMatching :many
+SELECT * FROM bans
+WHERE (expires_at IS NULL OR expires_at > ?)
+AND (user_id = ? OR username = ? OR ip_cidr IS NOT NULL)
+ORDER BY id;
+
+-- name: GetUserByID :one
+SELECT * FROM users
+WHERE id = ? LIMIT 1;
+
+-- name: SetUserRole :execrows
+UPDATE users SET role = ?
+WHERE id = ?;
+
+-- name: CreateAuditLogEntry :exec
+INSERT INTO audit_log (
+    user_id, actor_name, command, result
+) VALUES (
+    ?, ?, ?, ?
+);
+
+-- name: UnlinkAuditLogFromUser :exec
+UPDATE audit_log SET user_id = NULL
+WHERE user_id = ?;
+
+-- name: CreateMute :one
+INSERT INTO mutes (
+    user_id, username, ip, reason, issued_by, expires_at
+) VALUES (
+    ?, ?, ?, ?, ?, ?
+)
+RETURNING *;
+
+-- name: DeleteMutesMatching :execrows
+DELETE FROM mutes
+WHERE user_id = sqlc.arg(user_id) OR (user_id IS NULL AND username = sqlc.arg(username));
+
+-- name: UnlinkMutesFromUser :exec
+UPDATE mutes SET user_id = NULL
+WHERE user_id = ?;
+
+-- name: ListActiveMutesMatching :many
+SELECT * FROM mutes
+WHERE expires_at > sqlc.arg(expires_at)
+AND (user_id = sqlc.arg(user_id) OR (user_id IS NULL AND (username = sqlc.arg(username) OR ip = sqlc.arg(ip))))
+ORDER BY id;
+
+-- name: CreateChatReport :one
+INSERT INTO chat_reports (
+    reporter_id, reporter_name, reported_name, message, reason, context
+) VALUES (
+    ?, ?, ?, ?, ?, ?
+)
+RETURNING *;
+
+-- name: ListChatReports :many
+SELECT * FROM chat_reports
+ORDER BY id;
+
+-- name: UnlinkChatReportsFromReporter :exec
+UPDATE chat_reports SET reporter_id = NULL
+WHERE reporter_id = ?;
+
+-- name: CreateChatMessage :exec
+INSERT INTO chat_messages (
+    sender_name, message, created_at
+) VALUES (
+    ?, ?, ?
+);
+
+-- name: ListRecentChatMessages :many
+SELECT * FROM chat_messages
+ORDER BY id DESC
+LIMIT ?;
+
+-- name: ListChatMessagesBySender :many
+SELECT * FROM chat_messages
+WHERE sender_name = ?
+ORDER BY id DESC
+LIMIT ?;
+
+-- name: UpdateUserPassword :exec
+UPDATE users SET password_hash = ?
+WHERE id = ?;
+
+-- name: DeleteUser :exec
+DELETE FROM users
+WHERE id = ?;
+
+-- name: CreatePasswordReset :exec
+INSERT INTO password_resets (
+    user_id, code_hash, expires_at
+) VALUES (
+    ?, ?, ?
+);
+
+-- name: ListPasswordResetsByUser :many
+SELECT * FROM password_resets
+WHERE user_id = ?
+ORDER BY id;
+
+-- name: DeletePasswordResetsByUser :exec
+DELETE FROM password_resets
+WHERE user_id = ?;
+
+-- name: GetUserProfile :one
+SELECT * FROM user_profiles
+WHERE user_id = ? LIMIT 1;
+
+-- name: GetUserProfileByDisplayName :one
+SELECT * FROM user_profiles
+WHERE lower(display_name) = sqlc.arg(display_name) AND user_id != sqlc.arg(user_id)
+LIMIT 1;
+
+-- name: UpsertUserProfile :one
+INSERT INTO user_profiles (
+    user_id, color, display_name, skin_id, settings
+) VALUES (
+    ?, ?, ?, ?, ?
+)
+ON CONFLICT (user_id) DO UPDATE SET
+    color = excluded.color,
+    display_name = excluded.display_name,
+    skin_id = excluded.skin_id,
+    settings = excluded.settings,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: DeleteUserProfile :exec
+DELETE FROM user_profiles
+WHERE user_id = ?;
+
+-- name: CreateSession :exec
+INSERT INTO sessions (
+    user_id, player_name, room, started_at, ended_at, end_cause, killer_name, peak_mass, spores_eaten, players_eaten
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: ListRecentSessionsByUser :many
+SELECT * FROM sessions
+WHERE user_id = ?
+ORDER BY id DESC
+LIMIT ?;
+
+-- name: ListTopKillSessions :many
+SELECT * FROM sessions
+WHERE ended_at >= ?
+ORDER BY players_eaten DESC, peak_mass DESC
+LIMIT ?;
+
+-- name: DeleteSessionsByUser :exec
+DELETE FROM sessions
+WHERE user_id = ?;
+
+-- name: GetSessionTotalsByUser :one
+SELECT
+    CAST(COALESCE(SUM(spores_eaten), 0) AS INTEGER) AS spores_eaten,
+    CAST(COALESCE(SUM(players_eaten), 0) AS INTEGER) AS players_eaten
+FROM sessions
+WHERE user_id = ?;
+
+-- name: UnlockAchievement :exec
+INSERT INTO user_achievements (
+    user_id, achievement_id, unlocked_at
+) VALUES (
+    ?, ?, ?
+)
+ON CONFLICT (user_id, achievement_id) DO NOTHING;
+
+-- name: ListAchievementsByUser :many
+SELECT * FROM user_achievements
+WHERE user_id = ?
+ORDER BY unlocked_at;
+
+-- name: DeleteAchievementsByUser :exec
+DELETE FROM user_achievements
+WHERE user_id = ?;
+
+-- name: CreateFriendship :exec
+INSERT INTO friendships (
+    user_id, friend_id, status
+) VALUES (
+    ?, ?, ?
+);
+
+-- name: GetFriendship :one
+SELECT * FROM friendships
+WHERE user_id = ? AND friend_id = ? LIMIT 1;
+
+-- name: AcceptFriendship :execrows
+UPDATE friendships SET status = 'accepted'
+WHERE user_id = ? AND friend_id = ? AND status = 'pending';
+
+-- name: ListFriendsByUser :many
+SELECT friendships.friend_id, users.username, friendships.status
+FROM friendships
+JOIN users ON users.id = friendships.friend_id
+WHERE friendships.user_id = ?
+ORDER BY users.username;
+
+-- name: ListFriendRequestsToUser :many
+SELECT friendships.user_id, users.username
+FROM friendships
+JOIN users ON users.id = friendships.user_id
+WHERE friendships.friend_id = ? AND friendships.status = 'pending'
+ORDER BY users.username;
+
+-- name: DeleteFriendship :exec
+DELETE FROM friendships
+WHERE user_id = ? AND friend_id = ?;
+
+-- name: DeleteFriendshipsByUser :exec
+DELETE FROM friendships
+WHERE user_id = ? OR friend_id = ?;
+
+-- name: GetUserRating :one
+SELECT * FROM user_ratings
+WHERE user_id = ? LIMIT 1;
+
+-- name: DeleteUserRating :exec
+DELETE FROM user_ratings
+WHERE user_id = ?;
+
+-- name: UpsertUserRating :exec
+INSERT INTO user_ratings (
+    user_id, rating
+) VALUES (
+    ?, ?
+)
+ON CONFLICT (user_id) DO UPDATE SET
+    rating = excluded.rating,
+    updated_at = CURRENT_TIMESTAMP;
+
+-- name: CountUsersRatedAbove :one
+SELECT COUNT(*) FROM user_ratings
+WHERE rating > ?;
+
+-- name: ListTopRatings :many
+SELECT users.username, user_ratings.rating
+FROM user_ratings
+JOIN users ON users.id = user_ratings.user_id
+ORDER BY user_ratings.rating DESC, users.username
+LIMIT ?;
+
+-- name: CreateRatingChange :exec
+INSERT INTO rating_history (
+    user_id, rating, delta, placement, players, kills
+) VALUES (
+    ?, ?, ?, ?, ?, ?
+);
+
+-- name: ListRatingHistoryByUser :many
+SELECT * FROM rating_history
+WHERE user_id = ?
+ORDER BY id DESC
+LIMIT ?;
+
+-- name: DeleteRatingHistoryByUser :exec
+DELETE FROM rating_history
+WHERE user_id = ?;
@@ -4,8 +4,134 @@
 
 package db
 
+import (
+	"database/sql"
+	"time"
+)
+
+type AuditLog struct {
+	ID        int64
+	UserID    sql.NullInt64
+	ActorName string
+	Command   string
+	Result    string
+	CreatedAt time.Time
+}
+
+type Ban struct {
+	ID        int64
+	UserID    sql.NullInt64
+	Username  sql.NullString
+	IpCidr    sql.NullString
+	Reason    string
+	IssuedBy  string
+	CreatedAt time.Time
+	ExpiresAt sql.NullTime
+}
+
+type ChatMessage struct {
+	ID         int64
+	SenderName string
+	Message    string
+	CreatedAt  time.Time
+}
+
+type ChatReport struct {
+	ID           int64
+	ReporterID   sql.NullInt64
+	ReporterName string
+	ReportedName string
+	Message      string
+	Reason       string
+	Context      string
+	CreatedAt    time.Time
+}
+
+type CheatOffense struct {
+	ID         int64
+	UserID     sql.NullInt64
+	PlayerName string
+	Score      float64
+	Action     string
+	Details    string
+	CreatedAt  time.Time
+}
+
+type Friendship struct {
+	UserID    int64
+	FriendID  int64
+	Status    string
+	CreatedAt time.Time
+}
+
+type Mute struct {
+	ID        int64
+	UserID    sql.NullInt64
+	Username  string
+	Reason    string
+	IssuedBy  string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	Ip        sql.NullString
+}
+
+type PasswordReset struct {
+	ID        int64
+	UserID    int64
+	CodeHash  string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+type RatingHistory struct {
+	ID        int64
+	UserID    int64
+	Rating    float64
+	Delta     float64
+	Placement int64
+	Players   int64
+	Kills     int64
+	CreatedAt time.Time
+}
+
+type Session struct {
+	ID           int64
+	UserID       sql.NullInt64
+	PlayerName   string
+	Room         string
+	StartedAt    time.Time
+	EndedAt      time.Time
+	EndCause     string
+	KillerName   string
+	PeakMass     float64
+	SporesEaten  int64
+	PlayersEaten int64
+}
+
 type User struct {
 	ID           int64
 	Username     string
 	PasswordHash string
+	Role         string
+}
+
+type UserAchievement struct {
+	UserID        int64
+	AchievementID string
+	UnlockedAt    time.Time
+}
+
+type UserProfile struct {
+	UserID      int64
+	Color       int64
+	DisplayName string
+	SkinID      int64
+	Settings    []byte
+	UpdatedAt   time.Time
+}
+
+type UserRating struct {
+	UserID    int64
+	Rating    float64
+	UpdatedAt time.Time
 }
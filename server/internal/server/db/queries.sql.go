@@ -7,15 +7,345 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
 )
 
+const acceptFriendship = `-- name: AcceptFriendship :execrows
+UPDATE friendships SET status = 'accepted'
+WHERE user_id = ? AND friend_id = ? AND status = 'pending'
+`
+
+type AcceptFriendshipParams struct {
+	UserID   int64
+	FriendID int64
+}
+
+func (q *Queries) AcceptFriendship(ctx context.Context, arg AcceptFriendshipParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, acceptFriendship, arg.UserID, arg.FriendID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const countUsersRatedAbove = `-- name: CountUsersRatedAbove :one
+SELECT COUNT(*) FROM user_ratings
+WHERE rating > ?
+`
+
+func (q *Queries) CountUsersRatedAbove(ctx context.Context, rating float64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUsersRatedAbove, rating)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAuditLogEntry = `-- name: CreateAuditLogEntry :exec
+INSERT INTO audit_log (
+    user_id, actor_name, command, result
+) VALUES (
+    ?, ?, ?, ?
+)
+`
+
+type CreateAuditLogEntryParams struct {
+	UserID    sql.NullInt64
+	ActorName string
+	Command   string
+	Result    string
+}
+
+func (q *Queries) CreateAuditLogEntry(ctx context.Context, arg CreateAuditLogEntryParams) error {
+	_, err := q.db.ExecContext(ctx, createAuditLogEntry,
+		arg.UserID,
+		arg.ActorName,
+		arg.Command,
+		arg.Result,
+	)
+	return err
+}
+
+const createBan = `-- name: CreateBan :one
+INSERT INTO bans (
+    user_id, username, ip_cidr, reason, issued_by, expires_at
+) VALUES (
+    ?, ?, ?, ?, ?, ?
+)
+RETURNING id, user_id, username, ip_cidr, reason, issued_by, created_at, expires_at
+`
+
+type CreateBanParams struct {
+	UserID    sql.NullInt64
+	Username  sql.NullString
+	IpCidr    sql.NullString
+	Reason    string
+	IssuedBy  string
+	ExpiresAt sql.NullTime
+}
+
+func (q *Queries) CreateBan(ctx context.Context, arg CreateBanParams) (Ban, error) {
+	row := q.db.QueryRowContext(ctx, createBan,
+		arg.UserID,
+		arg.Username,
+		arg.IpCidr,
+		arg.Reason,
+		arg.IssuedBy,
+		arg.ExpiresAt,
+	)
+	var i Ban
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Username,
+		&i.IpCidr,
+		&i.Reason,
+		&i.IssuedBy,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const createChatMessage = `-- name: CreateChatMessage :exec
+INSERT INTO chat_messages (
+    sender_name, message, created_at
+) VALUES (
+    ?, ?, ?
+)
+`
+
+type CreateChatMessageParams struct {
+	SenderName string
+	Message    string
+	CreatedAt  time.Time
+}
+
+func (q *Queries) CreateChatMessage(ctx context.Context, arg CreateChatMessageParams) error {
+	_, err := q.db.ExecContext(ctx, createChatMessage, arg.SenderName, arg.Message, arg.CreatedAt)
+	return err
+}
+
+const createChatReport = `-- name: CreateChatReport :one
+INSERT INTO chat_reports (
+    reporter_id, reporter_name, reported_name, message, reason, context
+) VALUES (
+    ?, ?, ?, ?, ?, ?
+)
+RETURNING id, reporter_id, reporter_name, reported_name, message, reason, context, created_at
+`
+
+type CreateChatReportParams struct {
+	ReporterID   sql.NullInt64
+	ReporterName string
+	ReportedName string
+	Message      string
+	Reason       string
+	Context      string
+}
+
+func (q *Queries) CreateChatReport(ctx context.Context, arg CreateChatReportParams) (ChatReport, error) {
+	row := q.db.QueryRowContext(ctx, createChatReport,
+		arg.ReporterID,
+		arg.ReporterName,
+		arg.ReportedName,
+		arg.Message,
+		arg.Reason,
+		arg.Context,
+	)
+	var i ChatReport
+	err := row.Scan(
+		&i.ID,
+		&i.ReporterID,
+		&i.ReporterName,
+		&i.ReportedName,
+		&i.Message,
+		&i.Reason,
+		&i.Context,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createCheatOffense = `-- name: CreateCheatOffense :exec
+INSERT INTO cheat_offenses (
+    user_id, player_name, score, action, details
+) VALUES (
+    ?, ?, ?, ?, ?
+)
+`
+
+type CreateCheatOffenseParams struct {
+	UserID     sql.NullInt64
+	PlayerName string
+	Score      float64
+	Action     string
+	Details    string
+}
+
+func (q *Queries) CreateCheatOffense(ctx context.Context, arg CreateCheatOffenseParams) error {
+	_, err := q.db.ExecContext(ctx, createCheatOffense,
+		arg.UserID,
+		arg.PlayerName,
+		arg.Score,
+		arg.Action,
+		arg.Details,
+	)
+	return err
+}
+
+const createFriendship = `-- name: CreateFriendship :exec
+INSERT INTO friendships (
+    user_id, friend_id, status
+) VALUES (
+    ?, ?, ?
+)
+`
+
+type CreateFriendshipParams struct {
+	UserID   int64
+	FriendID int64
+	Status   string
+}
+
+func (q *Queries) CreateFriendship(ctx context.Context, arg CreateFriendshipParams) error {
+	_, err := q.db.ExecContext(ctx, createFriendship, arg.UserID, arg.FriendID, arg.Status)
+	return err
+}
+
+const createMute = `-- name: CreateMute :one
+INSERT INTO mutes (
+    user_id, username, ip, reason, issued_by, expires_at
+) VALUES (
+    ?, ?, ?, ?, ?, ?
+)
+RETURNING id, user_id, username, reason, issued_by, created_at, expires_at, ip
+`
+
+type CreateMuteParams struct {
+	UserID    sql.NullInt64
+	Username  string
+	Ip        sql.NullString
+	Reason    string
+	IssuedBy  string
+	ExpiresAt time.Time
+}
+
+func (q *Queries) CreateMute(ctx context.Context, arg CreateMuteParams) (Mute, error) {
+	row := q.db.QueryRowContext(ctx, createMute,
+		arg.UserID,
+		arg.Username,
+		arg.Ip,
+		arg.Reason,
+		arg.IssuedBy,
+		arg.ExpiresAt,
+	)
+	var i Mute
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Username,
+		&i.Reason,
+		&i.IssuedBy,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.Ip,
+	)
+	return i, err
+}
+
+const createPasswordReset = `-- name: CreatePasswordReset :exec
+INSERT INTO password_resets (
+    user_id, code_hash, expires_at
+) VALUES (
+    ?, ?, ?
+)
+`
+
+type CreatePasswordResetParams struct {
+	UserID    int64
+	CodeHash  string
+	ExpiresAt time.Time
+}
+
+func (q *Queries) CreatePasswordReset(ctx context.Context, arg CreatePasswordResetParams) error {
+	_, err := q.db.ExecContext(ctx, createPasswordReset, arg.UserID, arg.CodeHash, arg.ExpiresAt)
+	return err
+}
+
+const createRatingChange = `-- name: CreateRatingChange :exec
+INSERT INTO rating_history (
+    user_id, rating, delta, placement, players, kills
+) VALUES (
+    ?, ?, ?, ?, ?, ?
+)
+`
+
+type CreateRatingChangeParams struct {
+	UserID    int64
+	Rating    float64
+	Delta     float64
+	Placement int64
+	Players   int64
+	Kills     int64
+}
+
+func (q *Queries) CreateRatingChange(ctx context.Context, arg CreateRatingChangeParams) error {
+	_, err := q.db.ExecContext(ctx, createRatingChange,
+		arg.UserID,
+		arg.Rating,
+		arg.Delta,
+		arg.Placement,
+		arg.Players,
+		arg.Kills,
+	)
+	return err
+}
+
+const createSession = `-- name: CreateSession :exec
+INSERT INTO sessions (
+    user_id, player_name, room, started_at, ended_at, end_cause, killer_name, peak_mass, spores_eaten, players_eaten
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+)
+`
+
+type CreateSessionParams struct {
+	UserID       sql.NullInt64
+	PlayerName   string
+	Room         string
+	StartedAt    time.Time
+	EndedAt      time.Time
+	EndCause     string
+	KillerName   string
+	PeakMass     float64
+	SporesEaten  int64
+	PlayersEaten int64
+}
+
+func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) error {
+	_, err := q.db.ExecContext(ctx, createSession,
+		arg.UserID,
+		arg.PlayerName,
+		arg.Room,
+		arg.StartedAt,
+		arg.EndedAt,
+		arg.EndCause,
+		arg.KillerName,
+		arg.PeakMass,
+		arg.SporesEaten,
+		arg.PlayersEaten,
+	)
+	return err
+}
+
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (
     username, password_hash
 ) VALUES (
     ?, ?
 )
-RETURNING id, username, password_hash
+RETURNING id, username, password_hash, role
 `
 
 type CreateUserParams struct {
@@ -26,18 +356,996 @@ type CreateUserParams struct {
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
 	row := q.db.QueryRowContext(ctx, createUser, arg.Username, arg.PasswordHash)
 	var i User
-	err := row.Scan(&i.ID, &i.Username, &i.PasswordHash)
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.PasswordHash,
+		&i.Role,
+	)
+	return i, err
+}
+
+const deleteAchievementsByUser = `-- name: DeleteAchievementsByUser :exec
+DELETE FROM user_achievements
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteAchievementsByUser(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAchievementsByUser, userID)
+	return err
+}
+
+const deleteBan = `-- name: DeleteBan :execrows
+DELETE FROM bans
+WHERE id = ?
+`
+
+func (q *Queries) DeleteBan(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteBan, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteFriendship = `-- name: DeleteFriendship :exec
+DELETE FROM friendships
+WHERE user_id = ? AND friend_id = ?
+`
+
+type DeleteFriendshipParams struct {
+	UserID   int64
+	FriendID int64
+}
+
+func (q *Queries) DeleteFriendship(ctx context.Context, arg DeleteFriendshipParams) error {
+	_, err := q.db.ExecContext(ctx, deleteFriendship, arg.UserID, arg.FriendID)
+	return err
+}
+
+const deleteFriendshipsByUser = `-- name: DeleteFriendshipsByUser :exec
+DELETE FROM friendships
+WHERE user_id = ? OR friend_id = ?
+`
+
+type DeleteFriendshipsByUserParams struct {
+	UserID   int64
+	FriendID int64
+}
+
+func (q *Queries) DeleteFriendshipsByUser(ctx context.Context, arg DeleteFriendshipsByUserParams) error {
+	_, err := q.db.ExecContext(ctx, deleteFriendshipsByUser, arg.UserID, arg.FriendID)
+	return err
+}
+
+const deleteMutesMatching = `-- name: DeleteMutesMatching :execrows
+DELETE FROM mutes
+WHERE user_id = ? OR (user_id IS NULL AND username = ?)
+`
+
+type DeleteMutesMatchingParams struct {
+	UserID   sql.NullInt64
+	Username string
+}
+
+func (q *Queries) DeleteMutesMatching(ctx context.Context, arg DeleteMutesMatchingParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteMutesMatching, arg.UserID, arg.Username)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deletePasswordResetsByUser = `-- name: DeletePasswordResetsByUser :exec
+DELETE FROM password_resets
+WHERE user_id = ?
+`
+
+func (q *Queries) DeletePasswordResetsByUser(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deletePasswordResetsByUser, userID)
+	return err
+}
+
+const deleteRatingHistoryByUser = `-- name: DeleteRatingHistoryByUser :exec
+DELETE FROM rating_history
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteRatingHistoryByUser(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteRatingHistoryByUser, userID)
+	return err
+}
+
+const deleteSessionsByUser = `-- name: DeleteSessionsByUser :exec
+DELETE FROM sessions
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteSessionsByUser(ctx context.Context, userID sql.NullInt64) error {
+	_, err := q.db.ExecContext(ctx, deleteSessionsByUser, userID)
+	return err
+}
+
+const deleteUser = `-- name: DeleteUser :exec
+DELETE FROM users
+WHERE id = ?
+`
+
+func (q *Queries) DeleteUser(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteUser, id)
+	return err
+}
+
+const deleteUserProfile = `-- name: DeleteUserProfile :exec
+DELETE FROM user_profiles
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteUserProfile(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteUserProfile, userID)
+	return err
+}
+
+const deleteUserRating = `-- name: DeleteUserRating :exec
+DELETE FROM user_ratings
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteUserRating(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteUserRating, userID)
+	return err
+}
+
+const getFriendship = `-- name: GetFriendship :one
+SELECT user_id, friend_id, status, created_at FROM friendships
+WHERE user_id = ? AND friend_id = ? LIMIT 1
+`
+
+type GetFriendshipParams struct {
+	UserID   int64
+	FriendID int64
+}
+
+func (q *Queries) GetFriendship(ctx context.Context, arg GetFriendshipParams) (Friendship, error) {
+	row := q.db.QueryRowContext(ctx, getFriendship, arg.UserID, arg.FriendID)
+	var i Friendship
+	err := row.Scan(
+		&i.UserID,
+		&i.FriendID,
+		&i.Status,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getSessionTotalsByUser = `-- name: GetSessionTotalsByUser :one
+SELECT
+    CAST(COALESCE(SUM(spores_eaten), 0) AS INTEGER) AS spores_eaten,
+    CAST(COALESCE(SUM(players_eaten), 0) AS INTEGER) AS players_eaten
+FROM sessions
+WHERE user_id = ?
+`
+
+type GetSessionTotalsByUserRow struct {
+	SporesEaten  int64
+	PlayersEaten int64
+}
+
+func (q *Queries) GetSessionTotalsByUser(ctx context.Context, userID sql.NullInt64) (GetSessionTotalsByUserRow, error) {
+	row := q.db.QueryRowContext(ctx, getSessionTotalsByUser, userID)
+	var i GetSessionTotalsByUserRow
+	err := row.Scan(&i.SporesEaten, &i.PlayersEaten)
+	return i, err
+}
+
+const getUserByID = `-- name: GetUserByID :one
+SELECT id, username, password_hash, role FROM users
+WHERE id = ? LIMIT 1
+`
+
+func (q *Queries) GetUserByID(ctx context.Context, id int64) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByID, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.PasswordHash,
+		&i.Role,
+	)
 	return i, err
 }
 
 const getUserByUsername = `-- name: GetUserByUsername :one
-SELECT id, username, password_hash FROM users
+SELECT id, username, password_hash, role FROM users
 WHERE username = ? LIMIT 1
 `
 
 func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User, error) {
 	row := q.db.QueryRowContext(ctx, getUserByUsername, username)
 	var i User
-	err := row.Scan(&i.ID, &i.Username, &i.PasswordHash)
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.PasswordHash,
+		&i.Role,
+	)
+	return i, err
+}
+
+const getUserProfile = `-- name: GetUserProfile :one
+SELECT user_id, color, display_name, skin_id, settings, updated_at FROM user_profiles
+WHERE user_id = ? LIMIT 1
+`
+
+func (q *Queries) GetUserProfile(ctx context.Context, userID int64) (UserProfile, error) {
+	row := q.db.QueryRowContext(ctx, getUserProfile, userID)
+	var i UserProfile
+	err := row.Scan(
+		&i.UserID,
+		&i.Color,
+		&i.DisplayName,
+		&i.SkinID,
+		&i.Settings,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getUserProfileByDisplayName = `-- name: GetUserProfileByDisplayName :one
+SELECT user_id, color, display_name, skin_id, settings, updated_at FROM user_profiles
+WHERE lower(display_name) = ? AND user_id != ?
+LIMIT 1
+`
+
+type GetUserProfileByDisplayNameParams struct {
+	DisplayName string
+	UserID      int64
+}
+
+func (q *Queries) GetUserProfileByDisplayName(ctx context.Context, arg GetUserProfileByDisplayNameParams) (UserProfile, error) {
+	row := q.db.QueryRowContext(ctx, getUserProfileByDisplayName, arg.DisplayName, arg.UserID)
+	var i UserProfile
+	err := row.Scan(
+		&i.UserID,
+		&i.Color,
+		&i.DisplayName,
+		&i.SkinID,
+		&i.Settings,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getUserRating = `-- name: GetUserRating :one
+SELECT user_id, rating, updated_at FROM user_ratings
+WHERE user_id = ? LIMIT 1
+`
+
+func (q *Queries) GetUserRating(ctx context.Context, userID int64) (UserRating, error) {
+	row := q.db.QueryRowContext(ctx, getUserRating, userID)
+	var i UserRating
+	err := row.Scan(&i.UserID, &i.Rating, &i.UpdatedAt)
+	return i, err
+}
+
+const listAchievementsByUser = `-- name: ListAchievementsByUser :many
+SELECT user_id, achievement_id, unlocked_at FROM user_achievements
+WHERE user_id = ?
+ORDER BY unlocked_at
+`
+
+func (q *Queries) ListAchievementsByUser(ctx context.Context, userID int64) ([]UserAchievement, error) {
+	rows, err := q.db.QueryContext(ctx, listAchievementsByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserAchievement
+	for rows.Next() {
+		var i UserAchievement
+		if err := rows.Scan(&i.UserID, &i.AchievementID, &i.UnlockedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listActiveBansMatching = `-- name: ListActiveBansMatching :many
+SELECT id, user_id, username, ip_cidr, reason, issued_by, created_at, expires_at FROM bans
+WHERE (expires_at IS NULL OR expires_at > ?)
+AND (user_id = ? OR username = ? OR ip_cidr IS NOT NULL)
+ORDER BY id
+`
+
+type ListActiveBansMatchingParams struct {
+	ExpiresAt sql.NullTime
+	UserID    sql.NullInt64
+	Username  sql.NullString
+}
+
+func (q *Queries) ListActiveBansMatching(ctx context.Context, arg ListActiveBansMatchingParams) ([]Ban, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveBansMatching, arg.ExpiresAt, arg.UserID, arg.Username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Ban
+	for rows.Next() {
+		var i Ban
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Username,
+			&i.IpCidr,
+			&i.Reason,
+			&i.IssuedBy,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listActiveMutesMatching = `-- name: ListActiveMutesMatching :many
+SELECT id, user_id, username, reason, issued_by, created_at, expires_at, ip FROM mutes
+WHERE expires_at > ?
+AND (user_id = ? OR (user_id IS NULL AND (username = ? OR ip = ?)))
+ORDER BY id
+`
+
+type ListActiveMutesMatchingParams struct {
+	ExpiresAt time.Time
+	UserID    sql.NullInt64
+	Username  string
+	Ip        sql.NullString
+}
+
+func (q *Queries) ListActiveMutesMatching(ctx context.Context, arg ListActiveMutesMatchingParams) ([]Mute, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveMutesMatching,
+		arg.ExpiresAt,
+		arg.UserID,
+		arg.Username,
+		arg.Ip,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Mute
+	for rows.Next() {
+		var i Mute
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Username,
+			&i.Reason,
+			&i.IssuedBy,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.Ip,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBans = `-- name: ListBans :many
+SELECT id, user_id, username, ip_cidr, reason, issued_by, created_at, expires_at FROM bans
+ORDER BY id
+`
+
+func (q *Queries) ListBans(ctx context.Context) ([]Ban, error) {
+	rows, err := q.db.QueryContext(ctx, listBans)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Ban
+	for rows.Next() {
+		var i Ban
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Username,
+			&i.IpCidr,
+			&i.Reason,
+			&i.IssuedBy,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listChatMessagesBySender = `-- name: ListChatMessagesBySender :many
+SELECT id, sender_name, message, created_at FROM chat_messages
+WHERE sender_name = ?
+ORDER BY id DESC
+LIMIT ?
+`
+
+type ListChatMessagesBySenderParams struct {
+	SenderName string
+	Limit      int64
+}
+
+func (q *Queries) ListChatMessagesBySender(ctx context.Context, arg ListChatMessagesBySenderParams) ([]ChatMessage, error) {
+	rows, err := q.db.QueryContext(ctx, listChatMessagesBySender, arg.SenderName, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChatMessage
+	for rows.Next() {
+		var i ChatMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.SenderName,
+			&i.Message,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listChatReports = `-- name: ListChatReports :many
+SELECT id, reporter_id, reporter_name, reported_name, message, reason, context, created_at FROM chat_reports
+ORDER BY id
+`
+
+func (q *Queries) ListChatReports(ctx context.Context) ([]ChatReport, error) {
+	rows, err := q.db.QueryContext(ctx, listChatReports)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChatReport
+	for rows.Next() {
+		var i ChatReport
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReporterID,
+			&i.ReporterName,
+			&i.ReportedName,
+			&i.Message,
+			&i.Reason,
+			&i.Context,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFriendRequestsToUser = `-- name: ListFriendRequestsToUser :many
+SELECT friendships.user_id, users.username
+FROM friendships
+JOIN users ON users.id = friendships.user_id
+WHERE friendships.friend_id = ? AND friendships.status = 'pending'
+ORDER BY users.username
+`
+
+type ListFriendRequestsToUserRow struct {
+	UserID   int64
+	Username string
+}
+
+func (q *Queries) ListFriendRequestsToUser(ctx context.Context, friendID int64) ([]ListFriendRequestsToUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, listFriendRequestsToUser, friendID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFriendRequestsToUserRow
+	for rows.Next() {
+		var i ListFriendRequestsToUserRow
+		if err := rows.Scan(&i.UserID, &i.Username); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFriendsByUser = `-- name: ListFriendsByUser :many
+SELECT friendships.friend_id, users.username, friendships.status
+FROM friendships
+JOIN users ON users.id = friendships.friend_id
+WHERE friendships.user_id = ?
+ORDER BY users.username
+`
+
+type ListFriendsByUserRow struct {
+	FriendID int64
+	Username string
+	Status   string
+}
+
+func (q *Queries) ListFriendsByUser(ctx context.Context, userID int64) ([]ListFriendsByUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, listFriendsByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFriendsByUserRow
+	for rows.Next() {
+		var i ListFriendsByUserRow
+		if err := rows.Scan(&i.FriendID, &i.Username, &i.Status); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPasswordResetsByUser = `-- name: ListPasswordResetsByUser :many
+SELECT id, user_id, code_hash, created_at, expires_at FROM password_resets
+WHERE user_id = ?
+ORDER BY id
+`
+
+func (q *Queries) ListPasswordResetsByUser(ctx context.Context, userID int64) ([]PasswordReset, error) {
+	rows, err := q.db.QueryContext(ctx, listPasswordResetsByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PasswordReset
+	for rows.Next() {
+		var i PasswordReset
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CodeHash,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRatingHistoryByUser = `-- name: ListRatingHistoryByUser :many
+SELECT id, user_id, rating, delta, placement, players, kills, created_at FROM rating_history
+WHERE user_id = ?
+ORDER BY id DESC
+LIMIT ?
+`
+
+type ListRatingHistoryByUserParams struct {
+	UserID int64
+	Limit  int64
+}
+
+func (q *Queries) ListRatingHistoryByUser(ctx context.Context, arg ListRatingHistoryByUserParams) ([]RatingHistory, error) {
+	rows, err := q.db.QueryContext(ctx, listRatingHistoryByUser, arg.UserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RatingHistory
+	for rows.Next() {
+		var i RatingHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Rating,
+			&i.Delta,
+			&i.Placement,
+			&i.Players,
+			&i.Kills,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecentChatMessages = `-- name: ListRecentChatMessages :many
+SELECT id, sender_name, message, created_at FROM chat_messages
+ORDER BY id DESC
+LIMIT ?
+`
+
+func (q *Queries) ListRecentChatMessages(ctx context.Context, limit int64) ([]ChatMessage, error) {
+	rows, err := q.db.QueryContext(ctx, listRecentChatMessages, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChatMessage
+	for rows.Next() {
+		var i ChatMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.SenderName,
+			&i.Message,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecentSessionsByUser = `-- name: ListRecentSessionsByUser :many
+SELECT id, user_id, player_name, room, started_at, ended_at, end_cause, killer_name, peak_mass, spores_eaten, players_eaten FROM sessions
+WHERE user_id = ?
+ORDER BY id DESC
+LIMIT ?
+`
+
+type ListRecentSessionsByUserParams struct {
+	UserID sql.NullInt64
+	Limit  int64
+}
+
+func (q *Queries) ListRecentSessionsByUser(ctx context.Context, arg ListRecentSessionsByUserParams) ([]Session, error) {
+	rows, err := q.db.QueryContext(ctx, listRecentSessionsByUser, arg.UserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Session
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.PlayerName,
+			&i.Room,
+			&i.StartedAt,
+			&i.EndedAt,
+			&i.EndCause,
+			&i.KillerName,
+			&i.PeakMass,
+			&i.SporesEaten,
+			&i.PlayersEaten,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTopKillSessions = `-- name: ListTopKillSessions :many
+SELECT id, user_id, player_name, room, started_at, ended_at, end_cause, killer_name, peak_mass, spores_eaten, players_eaten FROM sessions
+WHERE ended_at >= ?
+ORDER BY players_eaten DESC, peak_mass DESC
+LIMIT ?
+`
+
+type ListTopKillSessionsParams struct {
+	EndedAt time.Time
+	Limit   int64
+}
+
+func (q *Queries) ListTopKillSessions(ctx context.Context, arg ListTopKillSessionsParams) ([]Session, error) {
+	rows, err := q.db.QueryContext(ctx, listTopKillSessions, arg.EndedAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Session
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.PlayerName,
+			&i.Room,
+			&i.StartedAt,
+			&i.EndedAt,
+			&i.EndCause,
+			&i.KillerName,
+			&i.PeakMass,
+			&i.SporesEaten,
+			&i.PlayersEaten,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTopRatings = `-- name: ListTopRatings :many
+SELECT users.username, user_ratings.rating
+FROM user_ratings
+JOIN users ON users.id = user_ratings.user_id
+ORDER BY user_ratings.rating DESC, users.username
+LIMIT ?
+`
+
+type ListTopRatingsRow struct {
+	Username string
+	Rating   float64
+}
+
+func (q *Queries) ListTopRatings(ctx context.Context, limit int64) ([]ListTopRatingsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTopRatings, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTopRatingsRow
+	for rows.Next() {
+		var i ListTopRatingsRow
+		if err := rows.Scan(&i.Username, &i.Rating); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setUserRole = `-- name: SetUserRole :execrows
+UPDATE users SET role = ?
+WHERE id = ?
+`
+
+type SetUserRoleParams struct {
+	Role string
+	ID   int64
+}
+
+func (q *Queries) SetUserRole(ctx context.Context, arg SetUserRoleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setUserRole, arg.Role, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const unlinkAuditLogFromUser = `-- name: UnlinkAuditLogFromUser :exec
+UPDATE audit_log SET user_id = NULL
+WHERE user_id = ?
+`
+
+func (q *Queries) UnlinkAuditLogFromUser(ctx context.Context, userID sql.NullInt64) error {
+	_, err := q.db.ExecContext(ctx, unlinkAuditLogFromUser, userID)
+	return err
+}
+
+const unlinkBansFromUser = `-- name: UnlinkBansFromUser :exec
+UPDATE bans SET user_id = NULL
+WHERE user_id = ?
+`
+
+func (q *Queries) UnlinkBansFromUser(ctx context.Context, userID sql.NullInt64) error {
+	_, err := q.db.ExecContext(ctx, unlinkBansFromUser, userID)
+	return err
+}
+
+const unlinkChatReportsFromReporter = `-- name: UnlinkChatReportsFromReporter :exec
+UPDATE chat_reports SET reporter_id = NULL
+WHERE reporter_id = ?
+`
+
+func (q *Queries) UnlinkChatReportsFromReporter(ctx context.Context, reporterID sql.NullInt64) error {
+	_, err := q.db.ExecContext(ctx, unlinkChatReportsFromReporter, reporterID)
+	return err
+}
+
+const unlinkCheatOffensesFromUser = `-- name: UnlinkCheatOffensesFromUser :exec
+UPDATE cheat_offenses SET user_id = NULL
+WHERE user_id = ?
+`
+
+func (q *Queries) UnlinkCheatOffensesFromUser(ctx context.Context, userID sql.NullInt64) error {
+	_, err := q.db.ExecContext(ctx, unlinkCheatOffensesFromUser, userID)
+	return err
+}
+
+const unlinkMutesFromUser = `-- name: UnlinkMutesFromUser :exec
+UPDATE mutes SET user_id = NULL
+WHERE user_id = ?
+`
+
+func (q *Queries) UnlinkMutesFromUser(ctx context.Context, userID sql.NullInt64) error {
+	_, err := q.db.ExecContext(ctx, unlinkMutesFromUser, userID)
+	return err
+}
+
+const unlockAchievement = `-- name: UnlockAchievement :exec
+INSERT INTO user_achievements (
+    user_id, achievement_id, unlocked_at
+) VALUES (
+    ?, ?, ?
+)
+ON CONFLICT (user_id, achievement_id) DO NOTHING
+`
+
+type UnlockAchievementParams struct {
+	UserID        int64
+	AchievementID string
+	UnlockedAt    time.Time
+}
+
+func (q *Queries) UnlockAchievement(ctx context.Context, arg UnlockAchievementParams) error {
+	_, err := q.db.ExecContext(ctx, unlockAchievement, arg.UserID, arg.AchievementID, arg.UnlockedAt)
+	return err
+}
+
+const updateUserPassword = `-- name: UpdateUserPassword :exec
+UPDATE users SET password_hash = ?
+WHERE id = ?
+`
+
+type UpdateUserPasswordParams struct {
+	PasswordHash string
+	ID           int64
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserPassword, arg.PasswordHash, arg.ID)
+	return err
+}
+
+const upsertUserProfile = `-- name: UpsertUserProfile :one
+INSERT INTO user_profiles (
+    user_id, color, display_name, skin_id, settings
+) VALUES (
+    ?, ?, ?, ?, ?
+)
+ON CONFLICT (user_id) DO UPDATE SET
+    color = excluded.color,
+    display_name = excluded.display_name,
+    skin_id = excluded.skin_id,
+    settings = excluded.settings,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING user_id, color, display_name, skin_id, settings, updated_at
+`
+
+type UpsertUserProfileParams struct {
+	UserID      int64
+	Color       int64
+	DisplayName string
+	SkinID      int64
+	Settings    []byte
+}
+
+func (q *Queries) UpsertUserProfile(ctx context.Context, arg UpsertUserProfileParams) (UserProfile, error) {
+	row := q.db.QueryRowContext(ctx, upsertUserProfile,
+		arg.UserID,
+		arg.Color,
+		arg.DisplayName,
+		arg.SkinID,
+		arg.Settings,
+	)
+	var i UserProfile
+	err := row.Scan(
+		&i.UserID,
+		&i.Color,
+		&i.DisplayName,
+		&i.SkinID,
+		&i.Settings,
+		&i.UpdatedAt,
+	)
 	return i, err
 }
+
+const upsertUserRating = `-- name: UpsertUserRating :exec
+INSERT INTO user_ratings (
+    user_id, rating
+) VALUES (
+    ?, ?
+)
+ON CONFLICT (user_id) DO UPDATE SET
+    rating = excluded.rating,
+    updated_at = CURRENT_TIMESTAMP
+`
+
+type UpsertUserRatingParams struct {
+	UserID int64
+	Rating float64
+}
+
+func (q *Queries) UpsertUserRating(ctx context.Context, arg UpsertUserRatingParams) error {
+	_, err := q.db.ExecContext(ctx, upsertUserRating, arg.UserID, arg.Rating)
+	return err
+}
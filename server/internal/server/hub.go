@@ -4,14 +4,25 @@ import (
 	"context"
 	"database/sql"
 	_ "embed"
+	"fmt"
 	"log"
 	"math/rand"
+	"net"
 	"net/http"
+	"regexp"
+	"server/internal/server/anticheat"
+	"server/internal/server/chatfilter"
 	"server/internal/server/db"
+	"server/internal/server/loginguard"
+	"server/internal/server/moderation"
+	"server/internal/server/names"
 	"server/internal/server/objects"
+	"server/internal/server/storage"
 	"server/pkg/packets"
+	"strconv"
 	"time"
 
+	_ "github.com/lib/pq"
 	_ "modernc.org/sqlite"
 )
 
@@ -22,9 +33,23 @@ const MaxSpores = 1000
 //go:embed db/config/schema.sql
 var schemaGenSql string
 
+// Embed the changes to tables created by older versions of the schema
+//
+//go:embed db/config/migrations.sql
+var migrationsSql string
+
+// Embed the schema of the user account tables for when they're kept in PostgreSQL
+//
+//go:embed db/config/postgres/schema.sql
+var postgresSchemaSql string
+
 type SharedGameObjects struct {
-	Players *objects.SharedCollection[*objects.Player]
-	Spores  *objects.SharedCollection[*objects.Spore]
+	Players  *objects.SharedCollection[*objects.Player]
+	Spores   *objects.SharedCollection[*objects.Spore]
+	PowerUps *objects.SharedCollection[*objects.PowerUp]
+	Teams    *Teams
+	Round    *Round
+	Chat     *ChatLog
 }
 
 // A structure for a state machine to process the client's messages
@@ -38,87 +63,268 @@ type ClientStateHandler interface {
 	OnExit()
 }
 
+// How long a transaction may take before it's rolled back
+const txTimeout = 5 * time.Second
+
 // A structure for db transactions context
 type DbTx struct {
 	Ctx     context.Context
 	Queries *db.Queries
+	// User accounts, which may be kept in another database than everything else
+	Users  storage.Users
+	dbPool *sql.DB
 }
 
 func (h *Hub) NewDbTx() *DbTx {
 	return &DbTx{
 		Ctx:     context.Background(),
 		Queries: db.New(h.dbPool),
+		Users:   h.users,
+		dbPool:  h.dbPool,
 	}
 }
 
+// Run fn in a transaction on the local database, committing if it returns nil and rolling back otherwise, or once
+// the transaction took too long. fn must only use the queries it's given, the in-memory database has a single
+// connection which the transaction holds on to.
+func (t *DbTx) WithTx(fn func(*db.Queries) error) error {
+	ctx, cancel := context.WithTimeout(t.Ctx, txTimeout)
+	defer cancel()
+
+	return storage.RunInTx(ctx, t.dbPool, func(tx *sql.Tx) error {
+		return fn(t.Queries.WithTx(tx))
+	})
+}
+
+// Run fn in a transaction on the accounts database, like WithTx
+func (t *DbTx) WithUsersTx(fn func(storage.Users) error) error {
+	ctx, cancel := context.WithTimeout(t.Ctx, txTimeout)
+	defer cancel()
+
+	return t.Users.WithTx(ctx, fn)
+}
+
 type ClientInterfacer interface {
 	Id() uint64
 	Initialize(id uint64)
 	SetState(newState ClientStateHandler)
 	ProcessMessage(senderId uint64, msg packets.Msg)
+	// Hand a message to the client's own goroutine, which processes it between the packets the client sends. Use it
+	// from other goroutines for anything which may change the client's state. Dropped once the client is closed.
+	Queue(message LocalMsg)
+	// The state the client is in, safe to call from any goroutine
+	State() ClientStateHandler
 	// Puts data from this client into the write pump
 	SocketSend(message packets.Msg)
 	// Puts dara from another client into the write pump
 	SocketSendAs(message packets.Msg, senderId uint64)
 	// Forward  message to another client for processing
 	PassToPeer(message packets.Msg, peerId uint64)
-	// Forward message to all other clients for processing
+	// Forward message to all other clients in the same room for processing
 	Broadcast(message packets.Msg)
+	// Forward message to all other clients for processing, whichever room they're in
+	BroadcastAll(message packets.Msg)
 	// Pump data from the connected socket directly to the client
 	ReadPump()
 	// Pump data from the client directly to the connected socket
 	WritePump()
 
+	// The game objects of the room the client is playing in, or last played in
 	SharedGameObjects() *SharedGameObjects
+	// The room the client is playing in, or last played in. nil if it never played.
+	Room() *Room
+	// Leave the current state and room, then enter the state in the given room
+	JoinRoom(room *Room, state ClientStateHandler)
+	// The skill rating the client's player is matched by
+	Rating() float64
+	SetRating(rating float64)
 	// Close the connection and clean up
 	Close(reason string)
 	// A reference to the db transaction context for this client
 	DbTx() *DbTx
+	// The settings of the hub this client is connected to
+	Config() Config
+	// Tracks suspicious behaviour of this client
+	AntiCheat() *anticheat.Tracker
+	// The IP address the client connected from
+	IP() string
+	// Look up another client connected to the hub
+	Peer(peerId uint64) (ClientInterfacer, bool)
+	// Close the connection, letting the client know why
+	Kick(reason string)
+	// Forward message to all clients, including this one, as coming from the server
+	SystemBroadcast(message packets.Msg)
+	// Checks the chat messages this client sends
+	ChatFilter() *chatfilter.Pipeline
+	// The last chat messages this client received
+	ChatHistory() *chatfilter.History
+	// How long the client's player is muted for
+	MuteStatus() *moderation.MuteStatus
+	// Guards password checks against brute forcing
+	LoginGuard() *loginguard.Guard
+	// The names the connected players are using
+	Names() *names.Registry
+	// Runs database queries and password hashing off the read pump
+	Workers() *WorkerPool
+	// Which registered users are online
+	Presence() *Presence
+	// The parties players formed
+	Parties() *Parties
+	// The rooms players can be placed in
+	Rooms() *Rooms
 }
 
 // The hub is the central point of communication between all connected clients
 type Hub struct {
 	Clients *objects.SharedCollection[ClientInterfacer]
-	// Packets in this channel will be processed by all connected clients except the sender
+	// Packets in this channel will be processed by all connected clients except the sender, whichever room they're in
 	BroadcastChan chan *packets.Packet
 	// Clients in this channel will be registered with the hub
 	RegisterChan chan ClientInterfacer
 	// Clients in this channel will be unregistered with the hub
-	UnregisterChan    chan ClientInterfacer
-	SharedGameObjects *SharedGameObjects
+	UnregisterChan chan ClientInterfacer
+	// The public chat messages, shared by every room
+	Chat       *ChatLog
+	Rooms      *Rooms
+	Config     Config
+	LoginGuard *loginguard.Guard
+	Names      *names.Registry
+	Workers    *WorkerPool
+	Presence   *Presence
+	Parties    *Parties
 	// Database connection pool
 	dbPool *sql.DB
+	// Where user accounts are kept
+	users storage.Users
+}
+
+// Open the database file at the given path and create the tables which don't exist yet. With InMemoryDb nothing is
+// written to disk and everything is gone when the server stops.
+func OpenDb(path string) (*sql.DB, error) {
+	// Wait for locks instead of failing straight away, since clients write to the database concurrently. Transactions
+	// take the write lock when they begin, so two of them can't both read and then fail to upgrade to writing.
+	dbPool, err := sql.Open("sqlite", path+"?_pragma=busy_timeout(5000)&_txlock=immediate")
+	if err != nil {
+		return nil, err
+	}
+	if path == InMemoryDb {
+		// Every connection would get a database of its own
+		dbPool.SetMaxOpenConns(1)
+	}
+
+	log.Println("Init db")
+	if _, err := dbPool.ExecContext(context.Background(), schemaGenSql); err != nil {
+		dbPool.Close()
+		return nil, err
+	}
+
+	if err := migrate(dbPool); err != nil {
+		dbPool.Close()
+		return nil, err
+	}
+	return dbPool, nil
 }
 
-func NewHub() *Hub {
-	dbPool, err := sql.Open("sqlite", "db.sqlite")
+// Open the database user accounts are kept in: PostgreSQL if a connection string is given, otherwise the local
+// database. The PostgreSQL tables are created if they don't exist yet.
+func OpenUsers(postgresDsn string, localDb *sql.DB) (storage.Users, error) {
+	if postgresDsn == "" {
+		return storage.NewSQLite(localDb), nil
+	}
+
+	dbPool, err := sql.Open("postgres", postgresDsn)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Println("Init accounts db")
+	if _, err := dbPool.ExecContext(context.Background(), postgresSchemaSql); err != nil {
+		dbPool.Close()
+		return nil, err
+	}
+	return storage.NewPostgres(dbPool), nil
+}
+
+// The line each migration starts with, capturing its version
+var migrationHeader = regexp.MustCompile(`(?m)^-- migration (\d+)$`)
+
+// Apply the migrations newer than the database's user_version, each in a transaction of its own
+func migrate(dbPool *sql.DB) error {
+	ctx := context.Background()
+	var applied int
+	if err := dbPool.QueryRowContext(ctx, "PRAGMA user_version").Scan(&applied); err != nil {
+		return fmt.Errorf("reading the schema version: %w", err)
+	}
+
+	headers := migrationHeader.FindAllStringSubmatchIndex(migrationsSql, -1)
+	for i, header := range headers {
+		version, err := strconv.Atoi(migrationsSql[header[2]:header[3]])
+		if err != nil || version != i+1 {
+			return fmt.Errorf("migration %d is numbered %s", i+1, migrationsSql[header[2]:header[3]])
+		}
+		if version <= applied {
+			continue
+		}
+
+		end := len(migrationsSql)
+		if i+1 < len(headers) {
+			end = headers[i+1][0]
+		}
+		err = storage.RunInTx(ctx, dbPool, func(tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, migrationsSql[header[1]:end]); err != nil {
+				return err
+			}
+			// Pragmas don't take parameters
+			_, err := tx.ExecContext(ctx, fmt.Sprintf("PRAGMA user_version = %d", version))
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("migration %d failed: %w", version, err)
+		}
+		log.Printf("Applied migration %d", version)
+	}
+	return nil
+}
+
+func NewHub(config Config) *Hub {
+	dbPool, err := OpenDb(config.DbPath)
 	if err != nil {
 		log.Fatal(err)
 	}
-	return &Hub{
+
+	users, err := OpenUsers(config.AccountsDb, dbPool)
+	if err != nil {
+		log.Fatalf("Error opening the accounts database: %v", err)
+	}
+
+	chatLog := NewChatLog(config.ChatHistorySize)
+	if config.PersistChat {
+		if err := chatLog.Persist(context.Background(), db.New(dbPool)); err != nil {
+			log.Fatalf("Error loading the chat history: %v", err)
+		}
+	}
+
+	hub := &Hub{
 		Clients:        objects.NewSharedCollection[ClientInterfacer](),
 		BroadcastChan:  make(chan *packets.Packet),
 		RegisterChan:   make(chan ClientInterfacer),
 		UnregisterChan: make(chan ClientInterfacer),
-		SharedGameObjects: &SharedGameObjects{
-			Players: objects.NewSharedCollection[*objects.Player](),
-			Spores:  objects.NewSharedCollection[*objects.Spore](),
-		},
-		dbPool: dbPool,
+		Chat:           chatLog,
+		Rooms:          NewRooms(config, chatLog),
+		Config:         config,
+		LoginGuard:     loginguard.NewGuard(),
+		Names:          names.NewRegistry(),
+		Workers:        NewWorkerPool(config.Workers, config.WorkerQueueSize, config.WorkerJobTimeout),
+		Presence:       NewPresence(),
+		dbPool:         dbPool,
+		users:          users,
 	}
+	hub.Parties = NewParties(config.MaxPartySize, hub.Rooms.Names)
+	return hub
 }
 
 func (h *Hub) Run() {
-	log.Println("Init db")
-	if _, err := h.dbPool.ExecContext(context.Background(), schemaGenSql); err != nil {
-		log.Fatal(err)
-	}
-
-	for i := 0; i < MaxSpores; i++ {
-		h.SharedGameObjects.Spores.Add(h.NewSpore())
-	}
-
-	go h.replenishSporesLoop(5 * time.Second)
+	h.Rooms.Start()
 
 	for {
 		select {
@@ -141,6 +347,18 @@ func (h *Hub) Run() {
 
 func (h *Hub) Serve(getNewClient func(*Hub, http.ResponseWriter, *http.Request) (ClientInterfacer, error), writer http.ResponseWriter, request *http.Request) {
 	log.Println("New connection", request.RemoteAddr)
+
+	// Turn banned addresses away before upgrading the connection
+	dbTx := h.NewDbTx()
+	ban, err := moderation.FindBan(dbTx.Ctx, dbTx.Queries, 0, "", RemoteIP(request))
+	if err != nil {
+		log.Println("Failed to check bans", err)
+	} else if ban != nil {
+		log.Printf("Refusing connection from banned address %s (ban %d)", request.RemoteAddr, ban.ID)
+		http.Error(writer, moderation.BanReason(ban), http.StatusForbidden)
+		return
+	}
+
 	client, err := getNewClient(h, writer, request)
 
 	if err != nil {
@@ -154,37 +372,22 @@ func (h *Hub) Serve(getNewClient func(*Hub, http.ResponseWriter, *http.Request)
 	go client.ReadPump()
 }
 
-func (h *Hub) NewSpore() *objects.Spore {
+// Get the IP address a request came from, without the port
+func RemoteIP(request *http.Request) string {
+	host, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		return request.RemoteAddr
+	}
+	return host
+}
+
+// Create a spore of random size somewhere it doesn't overlap anything, without adding it to the collection
+func (g *SharedGameObjects) NewSpore() *objects.Spore {
 	sporeRadius := max(10+rand.NormFloat64()*3, 5)
-	x, y := objects.SpawnCoords(sporeRadius, h.SharedGameObjects.Players, h.SharedGameObjects.Spores)
+	x, y := objects.SpawnCoords(sporeRadius, g.Players, g.Spores)
 	return &objects.Spore{
 		X:      x,
 		Y:      y,
 		Radius: sporeRadius,
 	}
 }
-
-func (h *Hub) replenishSporesLoop(rate time.Duration) {
-	ticker := time.NewTicker(rate)
-	defer ticker.Stop()
-	for range ticker.C {
-		sporesRemaining := h.SharedGameObjects.Spores.Len()
-		diff := MaxSpores - sporesRemaining
-
-		if diff <= 0 {
-			continue
-		}
-
-		log.Println("%d spores remaining, going to replenish %d", sporesRemaining, diff)
-		for i := 0; i < min(diff, 10); i++ {
-			spore := h.NewSpore()
-			h.SharedGameObjects.Spores.Add(spore)
-			h.BroadcastChan <- &packets.Packet{
-				SenderId: 0,
-				Msg:      packets.NewSpore(0, spore),
-			}
-
-			time.Sleep(100 * time.Millisecond)
-		}
-	}
-}
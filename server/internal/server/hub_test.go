@@ -0,0 +1,215 @@
+package server
+
+import (
+	"database/sql"
+	"errors"
+	"os"
+	"server/internal/server/db"
+	"server/internal/server/moderation"
+	"server/internal/server/storage"
+	"testing"
+	"time"
+)
+
+func TestNewHubInMemory(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	config := DefaultConfig()
+	config.DbPath = InMemoryDb
+	hub := NewHub(config)
+
+	dbTx := hub.NewDbTx()
+	if _, err := dbTx.Users.CreateUser(dbTx.Ctx, db.CreateUserParams{Username: "bob", PasswordHash: "hash"}); err != nil {
+		t.Fatalf("creating a user: %v", err)
+	}
+	if _, err := dbTx.Users.CreateUser(dbTx.Ctx, db.CreateUserParams{Username: "bob", PasswordHash: "hash"}); !errors.Is(err, storage.ErrUserExists) {
+		t.Errorf("expected creating the user twice to fail with ErrUserExists, got %v", err)
+	}
+	if err := hub.Chat.Store(dbTx.Ctx, hub.Chat.Add(1, "bob", "hello")); err != nil {
+		t.Fatalf("storing a chat message: %v", err)
+	}
+	if _, err := dbTx.Users.GetUserByUsername(dbTx.Ctx, "bob"); err != nil {
+		t.Fatalf("getting the user back: %v", err)
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		t.Errorf("the hub created %s", file.Name())
+	}
+}
+
+func TestDbTxRollsBack(t *testing.T) {
+	config := DefaultConfig()
+	config.DbPath = InMemoryDb
+	dbTx := NewHub(config).NewDbTx()
+	errFailed := errors.New("failed")
+
+	err := dbTx.WithTx(func(queries *db.Queries) error {
+		_, err := queries.CreateMute(dbTx.Ctx, db.CreateMuteParams{Username: "bob", ExpiresAt: time.Now().Add(time.Hour)})
+		if err != nil {
+			return err
+		}
+		return errFailed
+	})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("expected the error of the transaction, got %v", err)
+	}
+	if remaining, err := moderation.MuteRemaining(dbTx.Ctx, dbTx.Queries, 0, "bob", ""); err != nil || remaining != 0 {
+		t.Errorf("expected the mute to be rolled back, got %s, %v", remaining, err)
+	}
+
+	err = dbTx.WithUsersTx(func(users storage.Users) error {
+		if _, err := users.CreateUser(dbTx.Ctx, db.CreateUserParams{Username: "bob", PasswordHash: "hash"}); err != nil {
+			return err
+		}
+		return errFailed
+	})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("expected the error of the transaction, got %v", err)
+	}
+	if _, err := dbTx.Users.GetUserByUsername(dbTx.Ctx, "bob"); err == nil {
+		t.Error("expected the user to be rolled back")
+	}
+}
+
+func TestMigrateOnce(t *testing.T) {
+	dbPool, err := OpenDb(InMemoryDb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbPool.Close()
+
+	// Adding the role column again would fail, so a second run must skip it
+	if err := migrate(dbPool); err != nil {
+		t.Fatalf("migrating again: %v", err)
+	}
+
+	var version int
+	if err := dbPool.QueryRow("PRAGMA user_version").Scan(&version); err != nil {
+		t.Fatal(err)
+	}
+	if want := len(migrationHeader.FindAllString(migrationsSql, -1)); version != want {
+		t.Errorf("expected schema version %d, got %d", want, version)
+	}
+}
+
+func TestMutesFollowAccountsAndGuests(t *testing.T) {
+	config := DefaultConfig()
+	config.DbPath = InMemoryDb
+	dbTx := NewHub(config).NewDbTx()
+	ctx, queries := dbTx.Ctx, dbTx.Queries
+
+	mutes := []moderation.MuteParams{
+		{UserId: 1, Username: "Bob", IP: "10.0.0.1", Duration: time.Hour},
+		{Username: "Carol", IP: "10.0.0.2", Duration: time.Hour},
+	}
+	for _, params := range mutes {
+		if _, err := moderation.Mute(ctx, queries, params); err != nil {
+			t.Fatalf("muting %s: %v", params.Username, err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		userId   int64
+		username string
+		ip       string
+		muted    bool
+	}{
+		{"user under another display name", 1, "Robert", "10.0.0.9", true},
+		{"other user on the muted user's address", 2, "Dave", "10.0.0.1", false},
+		{"guest under the muted guest's name", 0, "carol", "10.0.0.9", true},
+		{"guest on the muted guest's address", 0, "Carol2", "10.0.0.2", true},
+		{"user on the muted guest's address", 2, "Carol", "10.0.0.2", false},
+		{"other guest", 0, "Erin", "10.0.0.9", false},
+	}
+	for _, test := range tests {
+		remaining, err := moderation.MuteRemaining(ctx, queries, test.userId, test.username, test.ip)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if muted := remaining > 0; muted != test.muted {
+			t.Errorf("%s: expected muted to be %v, got %s left", test.name, test.muted, remaining)
+		}
+	}
+
+	if err := moderation.Unmute(ctx, queries, 1, "Robert"); err != nil {
+		t.Fatalf("unmuting the user: %v", err)
+	}
+	if err := moderation.Unmute(ctx, queries, 0, "CAROL"); err != nil {
+		t.Fatalf("unmuting the guest: %v", err)
+	}
+	for _, test := range tests {
+		if remaining, err := moderation.MuteRemaining(ctx, queries, test.userId, test.username, test.ip); err != nil || remaining != 0 {
+			t.Errorf("%s: expected no mute left, got %s, %v", test.name, remaining, err)
+		}
+	}
+}
+
+func TestFindBan(t *testing.T) {
+	config := DefaultConfig()
+	config.DbPath = InMemoryDb
+	dbTx := NewHub(config).NewDbTx()
+	ctx, queries := dbTx.Ctx, dbTx.Queries
+
+	bans := []moderation.BanParams{
+		{UserId: 1, Reason: "user"},
+		{Username: "Mallory", Reason: "name"},
+		{IPCIDR: "10.0.0.1", Reason: "address", Duration: time.Hour},
+		{IPCIDR: "192.168.1.0/24", Reason: "range"},
+		{IPCIDR: "2001:db8::/32", Reason: "ipv6 range"},
+	}
+	for _, params := range bans {
+		if _, err := moderation.Ban(ctx, queries, params); err != nil {
+			t.Fatalf("banning for %s: %v", params.Reason, err)
+		}
+	}
+	_, err := queries.CreateBan(ctx, db.CreateBanParams{
+		Username:  sql.NullString{String: "eve", Valid: true},
+		IpCidr:    sql.NullString{String: "172.16.0.0/12", Valid: true},
+		Reason:    "expired",
+		ExpiresAt: sql.NullTime{Time: time.Now().Add(-time.Minute).UTC(), Valid: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		userId   int64
+		username string
+		ip       string
+		reason   string
+	}{
+		{"banned user", 1, "alice", "10.9.9.9", "user"},
+		{"other user", 2, "bob", "10.9.9.9", ""},
+		{"banned name in another case", 0, "MALLORY", "10.9.9.9", "name"},
+		{"banned name of a user", 3, "mallory", "10.9.9.9", "name"},
+		{"banned address", 2, "bob", "10.0.0.1", "address"},
+		{"address next to the banned one", 2, "bob", "10.0.0.2", ""},
+		{"address in a banned range", 0, "guest", "192.168.1.77", "range"},
+		{"address outside the banned range", 0, "guest", "192.168.2.1", ""},
+		{"ipv6 address in a banned range", 0, "guest", "2001:db8::1", "ipv6 range"},
+		{"expired name ban", 0, "eve", "10.9.9.9", ""},
+		{"expired range ban", 0, "guest", "172.16.0.1", ""},
+		{"no ip", 0, "guest", "", ""},
+		{"invalid ip", 0, "guest", "not an address", ""},
+	}
+	for _, test := range tests {
+		ban, err := moderation.FindBan(ctx, queries, test.userId, test.username, test.ip)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		reason := ""
+		if ban != nil {
+			reason = ban.Reason
+		}
+		if reason != test.reason {
+			t.Errorf("%s: expected the ban for %q, got the one for %q", test.name, test.reason, reason)
+		}
+	}
+}
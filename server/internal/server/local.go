@@ -0,0 +1,33 @@
+package server
+
+// A message another goroutine queues to a client, which handles it on its own goroutine between the packets the
+// client sends. Local messages never go over the network.
+type LocalMsg interface {
+	isLocalMsg()
+}
+
+// States which act on the local messages queued to their client, other than tasks
+type LocalMsgHandler interface {
+	HandleLocal(message LocalMsg)
+}
+
+// A function to run on the client's own goroutine, but only while the client is still in the state which queued it,
+// since it acts on that state. The results of jobs are handed back this way.
+type StateTask struct {
+	State ClientStateHandler
+	Run   func()
+}
+
+// Asks a client's state to move its player into another room, queued to the party's members when the leader chooses a
+// room for the party
+type MoveRoom struct {
+	Room string
+}
+
+func (*StateTask) isLocalMsg() {}
+func (*MoveRoom) isLocalMsg()  {}
+
+// Run fn on the client's own goroutine while it's still in the given state
+func QueueTask(client ClientInterfacer, state ClientStateHandler, fn func()) {
+	client.Queue(&StateTask{State: state, Run: fn})
+}
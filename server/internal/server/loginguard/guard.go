@@ -0,0 +1,255 @@
+package loginguard
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// How many failed password checks are allowed before the backoff kicks in, and before locking out entirely.
+// Addresses get more leeway than usernames since several players may share one.
+type limits struct {
+	freeAttempts    int
+	lockoutAttempts int
+}
+
+var (
+	usernameLimits = limits{freeAttempts: 3, lockoutAttempts: 10}
+	ipLimits       = limits{freeAttempts: 10, lockoutAttempts: 30}
+)
+
+const (
+	// The wait after the first failure past the free attempts, doubled with every failure after that
+	baseBackoff = time.Second
+	maxBackoff  = time.Minute
+	// How long a username or address is locked out for after too many failures
+	lockoutDuration = 15 * time.Minute
+	// Failures are forgotten after this long without a new one
+	forgetAfter = time.Hour
+	// How long a password check waits for one of the other checks to finish before giving up
+	maxHashingWait = 2 * time.Second
+)
+
+// The bcrypt hash of a random password nobody knows, with the default cost like the hashes of real passwords
+const dummyHash = "$2a$10$pDBfDshGLcFphoM4NLeS8.a.1sCZiuQtxjFRR3L0oScUuelDP09Su"
+
+// Returned when the server is too busy checking other passwords
+var ErrBusy = errors.New("too many password checks at once")
+
+// Returned when there were too many failed attempts for the username or the address
+type TooManyAttemptsError struct {
+	Wait   time.Duration
+	Locked bool
+}
+
+func (e *TooManyAttemptsError) Error() string {
+	return fmt.Sprintf("too many failed attempts, try again in %s", e.Wait)
+}
+
+// Failed attempts for one username or address
+type attempts struct {
+	failures    int
+	lastFailure time.Time
+	lockedUntil time.Time
+}
+
+// Counters for keeping an eye on password guessing
+type Stats struct {
+	Failures  uint64
+	Throttled uint64
+	Lockouts  uint64
+	Busy      uint64
+}
+
+// Protects password checks from brute forcing: failed attempts are tracked per username and per address with
+// an exponential backoff and a temporary lockout, and only a limited number of bcrypt comparisons run at once
+// so hammering the server can't use up all of its CPU.
+type Guard struct {
+	byUsername map[string]*attempts
+	byIP       map[string]*attempts
+	prunedAt   time.Time
+	stats      Stats
+	mux        sync.Mutex
+	// Holds a token for every password check in progress
+	hashing chan struct{}
+}
+
+func NewGuard() *Guard {
+	return &Guard{
+		byUsername: make(map[string]*attempts),
+		byIP:       make(map[string]*attempts),
+		hashing:    make(chan struct{}, runtime.NumCPU()),
+	}
+}
+
+// Check a password against its bcrypt hash, unless the username or address has to wait after failing too often.
+// Pass an empty hash for unknown users, which counts as a failure so usernames can't be told apart from wrong passwords.
+func (g *Guard) CompareHashAndPassword(username string, ip string, hash string, password string) error {
+	var hashes []string
+	if hash != "" {
+		hashes = []string{hash}
+	}
+	return g.CompareHashesAndSecret(username, ip, hashes, password)
+}
+
+// Check a secret, like a password or a password reset code, against bcrypt hashes under the same limits as passwords.
+// It's right if any of the hashes match; no hashes at all counts as a failure.
+func (g *Guard) CompareHashesAndSecret(username string, ip string, hashes []string, secret string) error {
+	username = strings.ToLower(username)
+	if err := g.allow(username, ip); err != nil {
+		return err
+	}
+
+	select {
+	case g.hashing <- struct{}{}:
+	case <-time.After(maxHashingWait):
+		g.mux.Lock()
+		g.stats.Busy++
+		g.mux.Unlock()
+		return ErrBusy
+	}
+	err := compareHashes(hashes, secret)
+	<-g.hashing
+
+	if err != nil {
+		g.fail(username, ip)
+		return err
+	}
+
+	// The address keeps its failures, someone guessing the passwords of many users may get one right
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	delete(g.byUsername, username)
+	return nil
+}
+
+// Without any hashes the secret is compared against a dummy one, so it takes as long to refuse as a wrong secret
+func compareHashes(hashes []string, secret string) error {
+	if len(hashes) == 0 {
+		bcrypt.CompareHashAndPassword([]byte(dummyHash), []byte(secret))
+		return bcrypt.ErrMismatchedHashAndPassword
+	}
+
+	var err error
+	for _, hash := range hashes {
+		if err = bcrypt.CompareHashAndPassword([]byte(hash), []byte(secret)); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+func (g *Guard) Stats() Stats {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	return g.stats
+}
+
+// Refuse the attempt if the username or the address is locked out or still backing off
+func (g *Guard) allow(username string, ip string) error {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+
+	now := time.Now()
+	var refused *TooManyAttemptsError
+	for _, check := range []struct {
+		attempts *attempts
+		limits   limits
+	}{
+		{g.byUsername[username], usernameLimits},
+		{g.byIP[ip], ipLimits},
+	} {
+		if check.attempts == nil {
+			continue
+		}
+
+		err := &TooManyAttemptsError{}
+		if now.Before(check.attempts.lockedUntil) {
+			err.Wait, err.Locked = check.attempts.lockedUntil.Sub(now), true
+		} else if wait := check.attempts.lastFailure.Add(backoff(check.attempts.failures, check.limits)).Sub(now); wait > 0 {
+			err.Wait = wait
+		}
+
+		if err.Wait > 0 && (refused == nil || err.Wait > refused.Wait) {
+			refused = err
+		}
+	}
+
+	if refused != nil {
+		// Round up so the client isn't told to wait 0 seconds
+		refused.Wait = (refused.Wait + time.Second - 1).Truncate(time.Second)
+		g.stats.Throttled++
+		return refused
+	}
+	return nil
+}
+
+func (g *Guard) fail(username string, ip string) {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+
+	now := time.Now()
+	g.stats.Failures++
+	g.prune(now)
+
+	g.record(g.byUsername, username, "username "+username, usernameLimits, now)
+	g.record(g.byIP, ip, "address "+ip, ipLimits, now)
+}
+
+func (g *Guard) record(byKey map[string]*attempts, key string, description string, limits limits, now time.Time) {
+	if key == "" {
+		return
+	}
+
+	a, exists := byKey[key]
+	if !exists {
+		a = &attempts{}
+		byKey[key] = a
+	}
+
+	// Start over once a lockout has passed
+	if !a.lockedUntil.IsZero() && now.After(a.lockedUntil) {
+		*a = attempts{}
+	}
+
+	a.failures++
+	a.lastFailure = now
+	if a.failures >= limits.lockoutAttempts && a.lockedUntil.IsZero() {
+		a.lockedUntil = now.Add(lockoutDuration)
+		g.stats.Lockouts++
+		log.Printf("Login guard: locked out %s for %s after %d failed attempts (%d lockouts so far)",
+			description, lockoutDuration, a.failures, g.stats.Lockouts)
+	}
+}
+
+// Forget about failures which happened long ago, at most once a minute
+func (g *Guard) prune(now time.Time) {
+	if now.Sub(g.prunedAt) < time.Minute {
+		return
+	}
+	g.prunedAt = now
+
+	for _, byKey := range []map[string]*attempts{g.byUsername, g.byIP} {
+		for key, a := range byKey {
+			if now.Sub(a.lastFailure) > forgetAfter && now.After(a.lockedUntil) {
+				delete(byKey, key)
+			}
+		}
+	}
+}
+
+// How long to wait after the latest of the given number of failures
+func backoff(failures int, limits limits) time.Duration {
+	if failures <= limits.freeAttempts {
+		return 0
+	}
+	wait := float64(baseBackoff) * math.Pow(2, float64(min(failures-limits.freeAttempts-1, 16)))
+	return min(time.Duration(wait), maxBackoff)
+}
@@ -0,0 +1,146 @@
+package loginguard
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func hash(t *testing.T, password string) string {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("failed to hash the password: %v", err)
+	}
+	return string(hash)
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{0, 0},
+		{usernameLimits.freeAttempts, 0},
+		{usernameLimits.freeAttempts + 1, time.Second},
+		{usernameLimits.freeAttempts + 2, 2 * time.Second},
+		{usernameLimits.freeAttempts + 4, 8 * time.Second},
+		{usernameLimits.freeAttempts + 100, maxBackoff},
+	}
+
+	for _, test := range tests {
+		if got := backoff(test.failures, usernameLimits); got != test.want {
+			t.Errorf("after %d failures: expected %s, got %s", test.failures, test.want, got)
+		}
+	}
+}
+
+func TestWrongPasswordBacksOff(t *testing.T) {
+	g := NewGuard()
+	hash := hash(t, "right")
+
+	for i := range usernameLimits.freeAttempts + 1 {
+		if err := g.CompareHashAndPassword("Alice", "1.2.3.4", hash, "wrong"); !errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			t.Fatalf("attempt %d: expected a mismatch, got %v", i+1, err)
+		}
+	}
+
+	// Even the right password has to wait, whatever case the username is in
+	var tooMany *TooManyAttemptsError
+	err := g.CompareHashAndPassword("alice", "5.6.7.8", hash, "right")
+	if !errors.As(err, &tooMany) {
+		t.Fatalf("expected to be told to wait, got %v", err)
+	}
+	if tooMany.Locked || tooMany.Wait != baseBackoff {
+		t.Errorf("expected to wait %s without a lockout, got %s (locked: %t)", baseBackoff, tooMany.Wait, tooMany.Locked)
+	}
+
+	// Other users aren't affected
+	if err := g.CompareHashAndPassword("bob", "5.6.7.8", hash, "right"); err != nil {
+		t.Errorf("expected another user to log in, got %v", err)
+	}
+
+	stats := g.Stats()
+	if stats.Failures != uint64(usernameLimits.freeAttempts+1) || stats.Throttled != 1 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestSuccessForgetsFailures(t *testing.T) {
+	g := NewGuard()
+	hash := hash(t, "right")
+
+	for range usernameLimits.freeAttempts {
+		g.CompareHashAndPassword("alice", "1.2.3.4", hash, "wrong")
+	}
+	if err := g.CompareHashAndPassword("alice", "1.2.3.4", hash, "right"); err != nil {
+		t.Fatalf("expected the right password to be accepted, got %v", err)
+	}
+	if err := g.CompareHashAndPassword("alice", "1.2.3.4", hash, "wrong"); !errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		t.Errorf("expected a free attempt after logging in, got %v", err)
+	}
+}
+
+func TestLockout(t *testing.T) {
+	g := NewGuard()
+	for range usernameLimits.lockoutAttempts {
+		g.fail("alice", "")
+	}
+
+	var tooMany *TooManyAttemptsError
+	if err := g.allow("alice", "1.2.3.4"); !errors.As(err, &tooMany) || !tooMany.Locked {
+		t.Fatalf("expected the username to be locked out, got %v", err)
+	}
+	if tooMany.Wait > lockoutDuration || tooMany.Wait < lockoutDuration-time.Minute {
+		t.Errorf("expected to wait about %s, got %s", lockoutDuration, tooMany.Wait)
+	}
+	if g.Stats().Lockouts != 1 {
+		t.Errorf("expected one lockout, got %d", g.Stats().Lockouts)
+	}
+
+	// Failing again once the lockout has passed starts the count over
+	g.byUsername["alice"].lockedUntil = time.Now().Add(-time.Second)
+	g.fail("alice", "")
+	if failures := g.byUsername["alice"].failures; failures != 1 {
+		t.Errorf("expected the failures to start over after the lockout, got %d", failures)
+	}
+}
+
+func TestAddressLockout(t *testing.T) {
+	g := NewGuard()
+	// Guessing the passwords of many users from one address
+	for i := range ipLimits.lockoutAttempts {
+		g.fail(string(rune('a'+i)), "1.2.3.4")
+	}
+
+	var tooMany *TooManyAttemptsError
+	if err := g.allow("someone else", "1.2.3.4"); !errors.As(err, &tooMany) || !tooMany.Locked {
+		t.Errorf("expected the address to be locked out, got %v", err)
+	}
+	if err := g.allow("someone else", "5.6.7.8"); err != nil {
+		t.Errorf("expected other addresses to be allowed, got %v", err)
+	}
+}
+
+func TestUnknownUser(t *testing.T) {
+	g := NewGuard()
+	if err := g.CompareHashAndPassword("nobody", "1.2.3.4", "", "anything"); !errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		t.Errorf("expected an unknown user to look like a wrong password, got %v", err)
+	}
+	if g.Stats().Failures != 1 {
+		t.Error("expected an unknown user to count as a failure")
+	}
+}
+
+func TestAnyHashMatches(t *testing.T) {
+	g := NewGuard()
+	hashes := []string{hash(t, "first"), hash(t, "second")}
+	if err := g.CompareHashesAndSecret("alice", "1.2.3.4", hashes, "second"); err != nil {
+		t.Errorf("expected the second code to be accepted, got %v", err)
+	}
+	if err := g.CompareHashesAndSecret("alice", "1.2.3.4", hashes, "third"); err == nil {
+		t.Error("expected a code matching neither hash to be refused")
+	}
+}
@@ -0,0 +1,67 @@
+package matchmaking
+
+import "math"
+
+const (
+	// A difference in rating this big counts as much against a room as it being empty rather than full counts for it
+	ratingScale = 400.0
+	// Rooms whose players are rated further apart from the player than this are only picked if no room can be opened
+	maxRatingGap = 600.0
+)
+
+// What the matchmaker needs to know about a room which is open
+type Room struct {
+	Region   string
+	Players  int
+	Capacity int
+	// The average rating of the players in the room, ignored while it's empty
+	Rating float64
+}
+
+// Someone who wants to play, along with anyone who has to play in the same room
+type Player struct {
+	// The region the player asked for, empty to be placed anywhere
+	Region string
+	Rating float64
+	// The number of players to be placed together, at least 1
+	Size int
+}
+
+// Pick the room which suits the player best: one in their region with space for them, preferring fuller rooms and
+// rooms with players of a similar rating. Returns -1 if none is good enough, in which case a new room should be opened
+// if canOpen; otherwise the requirements are relaxed, and -1 means every room is full.
+func Pick(rooms []Room, player Player, canOpen bool) int {
+	if best := pick(rooms, player, true, true); best >= 0 || canOpen {
+		return best
+	}
+	if best := pick(rooms, player, true, false); best >= 0 {
+		return best
+	}
+	return pick(rooms, player, false, false)
+}
+
+func pick(rooms []Room, player Player, sameRegion bool, closeRating bool) int {
+	best, bestScore := -1, math.Inf(-1)
+	for i, room := range rooms {
+		if room.Players+max(player.Size, 1) > room.Capacity {
+			continue
+		}
+		if sameRegion && player.Region != "" && room.Region != player.Region {
+			continue
+		}
+
+		score := float64(room.Players) / float64(room.Capacity)
+		if room.Players > 0 {
+			gap := math.Abs(room.Rating - player.Rating)
+			if closeRating && gap > maxRatingGap {
+				continue
+			}
+			score -= gap / ratingScale
+		}
+
+		if score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	return best
+}
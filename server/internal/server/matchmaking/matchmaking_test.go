@@ -0,0 +1,50 @@
+package matchmaking
+
+import "testing"
+
+func TestPick(t *testing.T) {
+	solo := Player{Region: "eu", Rating: 1500, Size: 1}
+	tests := []struct {
+		name    string
+		rooms   []Room
+		player  Player
+		canOpen bool
+		want    int
+	}{
+		{"no rooms", nil, solo, true, -1},
+		{"empty room", []Room{{Region: "eu", Capacity: 10}}, solo, true, 0},
+		{"fuller room", []Room{
+			{Region: "eu", Players: 2, Capacity: 10, Rating: 1500},
+			{Region: "eu", Players: 8, Capacity: 10, Rating: 1500},
+		}, solo, true, 1},
+		{"full room", []Room{{Region: "eu", Players: 10, Capacity: 10, Rating: 1500}}, solo, false, -1},
+		{"no space for the party", []Room{
+			{Region: "eu", Players: 8, Capacity: 10, Rating: 1500},
+			{Region: "eu", Players: 1, Capacity: 10, Rating: 1500},
+		}, Player{Region: "eu", Rating: 1500, Size: 3}, true, 1},
+		{"size 0 counts as 1", []Room{{Region: "eu", Players: 9, Capacity: 10, Rating: 1500}}, Player{Region: "eu", Rating: 1500}, true, 0},
+		{"closer rating", []Room{
+			{Region: "eu", Players: 8, Capacity: 10, Rating: 2000},
+			{Region: "eu", Players: 6, Capacity: 10, Rating: 1550},
+		}, solo, true, 1},
+		{"rating of an empty room ignored", []Room{{Region: "eu", Capacity: 10, Rating: 3000}}, solo, true, 0},
+		{"other region", []Room{{Region: "us", Players: 5, Capacity: 10, Rating: 1500}}, solo, true, -1},
+		{"other region when none can be opened", []Room{{Region: "us", Players: 5, Capacity: 10, Rating: 1500}}, solo, false, 0},
+		{"any region", []Room{
+			{Region: "us", Players: 5, Capacity: 10, Rating: 1500},
+			{Region: "eu", Players: 2, Capacity: 10, Rating: 1500},
+		}, Player{Rating: 1500, Size: 1}, true, 0},
+		{"rating too far apart", []Room{{Region: "eu", Players: 5, Capacity: 10, Rating: 2200}}, solo, true, -1},
+		{"rating too far apart when none can be opened", []Room{{Region: "eu", Players: 5, Capacity: 10, Rating: 2200}}, solo, false, 0},
+		{"region before rating when none can be opened", []Room{
+			{Region: "us", Players: 5, Capacity: 10, Rating: 1500},
+			{Region: "eu", Players: 5, Capacity: 10, Rating: 2200},
+		}, solo, false, 1},
+	}
+
+	for _, test := range tests {
+		if got := Pick(test.rooms, test.player, test.canOpen); got != test.want {
+			t.Errorf("%s: expected room %d, got %d", test.name, test.want, got)
+		}
+	}
+}
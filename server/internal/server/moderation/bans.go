@@ -0,0 +1,142 @@
+package moderation
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"server/internal/server/db"
+	"strings"
+	"time"
+)
+
+// Who or what to ban, at least one of UserId, Username and IPCIDR has to be set
+type BanParams struct {
+	UserId   int64
+	Username string
+	// A single IP address or a CIDR range such as 10.0.0.0/8
+	IPCIDR   string
+	Reason   string
+	IssuedBy string
+	// How long the ban lasts, 0 bans forever
+	Duration time.Duration
+}
+
+// Ban a user, a name or an address
+func Ban(ctx context.Context, queries *db.Queries, params BanParams) (db.Ban, error) {
+	if params.UserId == 0 && params.Username == "" && params.IPCIDR == "" {
+		return db.Ban{}, fmt.Errorf("nothing to ban, need a user, a username or an IP address")
+	}
+
+	ipCidr := params.IPCIDR
+	if ipCidr != "" {
+		network, err := parseCIDR(ipCidr)
+		if err != nil {
+			return db.Ban{}, err
+		}
+		ipCidr = network.String()
+	}
+
+	var expiresAt sql.NullTime
+	if params.Duration > 0 {
+		expiresAt = sql.NullTime{Time: time.Now().Add(params.Duration).UTC(), Valid: true}
+	}
+
+	return queries.CreateBan(ctx, db.CreateBanParams{
+		UserID:    sql.NullInt64{Int64: params.UserId, Valid: params.UserId != 0},
+		Username:  sql.NullString{String: strings.ToLower(params.Username), Valid: params.Username != ""},
+		IpCidr:    sql.NullString{String: ipCidr, Valid: ipCidr != ""},
+		Reason:    params.Reason,
+		IssuedBy:  params.IssuedBy,
+		ExpiresAt: expiresAt,
+	})
+}
+
+// Lift a ban, returns an error if there is no ban with the given id
+func Unban(ctx context.Context, queries *db.Queries, banId int64) error {
+	deleted, err := queries.DeleteBan(ctx, banId)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return fmt.Errorf("ban %d does not exist", banId)
+	}
+	return nil
+}
+
+// Get the bans which haven't expired yet
+func ActiveBans(ctx context.Context, queries *db.Queries) ([]db.Ban, error) {
+	bans, err := queries.ListBans(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	active := make([]db.Ban, 0, len(bans))
+	now := time.Now()
+	for _, ban := range bans {
+		if !ban.ExpiresAt.Valid || ban.ExpiresAt.Time.After(now) {
+			active = append(active, ban)
+		}
+	}
+	return active, nil
+}
+
+// Find an active ban matching the user id, the username or the IP address, any of which may be left empty.
+// Returns nil if none of them are banned.
+func FindBan(ctx context.Context, queries *db.Queries, userId int64, username string, ip string) (*db.Ban, error) {
+	// Ranges can't be matched in SQL, so every active IP ban comes back along with the bans of the user
+	username = strings.ToLower(username)
+	bans, err := queries.ListActiveBansMatching(ctx, db.ListActiveBansMatchingParams{
+		ExpiresAt: sql.NullTime{Time: time.Now().UTC(), Valid: true},
+		UserID:    sql.NullInt64{Int64: userId, Valid: userId != 0},
+		Username:  sql.NullString{String: username, Valid: username != ""},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	parsedIp := net.ParseIP(ip)
+	for _, ban := range bans {
+		if userId != 0 && ban.UserID.Valid && ban.UserID.Int64 == userId {
+			return &ban, nil
+		}
+		if username != "" && ban.Username.Valid && ban.Username.String == username {
+			return &ban, nil
+		}
+		if parsedIp != nil && ban.IpCidr.Valid {
+			if network, err := parseCIDR(ban.IpCidr.String); err == nil && network.Contains(parsedIp) {
+				return &ban, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// The reason shown to a banned player
+func BanReason(ban *db.Ban) string {
+	reason := "You are banned: " + ban.Reason
+	if ban.ExpiresAt.Valid {
+		reason += fmt.Sprintf(" (until %s)", ban.ExpiresAt.Time.UTC().Format(time.RFC1123))
+	}
+	return reason
+}
+
+// Parse a CIDR range, treating a plain IP address as a range containing only that address
+func parseCIDR(ipCidr string) (*net.IPNet, error) {
+	if !strings.Contains(ipCidr, "/") {
+		ip := net.ParseIP(ipCidr)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IP address %q", ipCidr)
+		}
+		if ipv4 := ip.To4(); ipv4 != nil {
+			return &net.IPNet{IP: ipv4, Mask: net.CIDRMask(32, 32)}, nil
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+	}
+
+	_, network, err := net.ParseCIDR(ipCidr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CIDR range %q: %w", ipCidr, err)
+	}
+	return network, nil
+}
@@ -0,0 +1,33 @@
+package moderation
+
+import "testing"
+
+func TestParseCIDR(t *testing.T) {
+	tests := []struct {
+		ipCidr string
+		want   string
+		ok     bool
+	}{
+		{"10.0.0.1", "10.0.0.1/32", true},
+		{"10.0.0.0/8", "10.0.0.0/8", true},
+		{"10.1.2.3/8", "10.0.0.0/8", true},
+		{"2001:db8::1", "2001:db8::1/128", true},
+		{"2001:db8::/32", "2001:db8::/32", true},
+		{"10.0.0.0/33", "", false},
+		{"10.0.0", "", false},
+		{"", "", false},
+	}
+
+	for _, test := range tests {
+		network, err := parseCIDR(test.ipCidr)
+		if !test.ok {
+			if err == nil {
+				t.Errorf("%q: expected an error, got %s", test.ipCidr, network)
+			}
+			continue
+		}
+		if err != nil || network.String() != test.want {
+			t.Errorf("%q: expected %s, got %v (%v)", test.ipCidr, test.want, network, err)
+		}
+	}
+}
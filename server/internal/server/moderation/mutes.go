@@ -0,0 +1,108 @@
+package moderation
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"server/internal/server/db"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Who to mute. Registered users are muted by their account, so a new display name doesn't get them out of it. Guests
+// are muted by name and by the address they connected from, since they can come back under another name.
+type MuteParams struct {
+	UserId   int64
+	Username string
+	// The address a guest is connected from, empty if they're offline
+	IP       string
+	Reason   string
+	IssuedBy string
+	// How long the mute lasts
+	Duration time.Duration
+}
+
+// Stop a player from chatting until the mute expires
+func Mute(ctx context.Context, queries *db.Queries, params MuteParams) (db.Mute, error) {
+	if params.Username == "" {
+		return db.Mute{}, fmt.Errorf("nothing to mute, need a username")
+	}
+	if params.Duration <= 0 {
+		return db.Mute{}, fmt.Errorf("a mute needs a duration")
+	}
+
+	ip := params.IP
+	if params.UserId != 0 {
+		ip = ""
+	}
+	return queries.CreateMute(ctx, db.CreateMuteParams{
+		UserID:    sql.NullInt64{Int64: params.UserId, Valid: params.UserId != 0},
+		Username:  strings.ToLower(params.Username),
+		Ip:        sql.NullString{String: ip, Valid: ip != ""},
+		Reason:    params.Reason,
+		IssuedBy:  params.IssuedBy,
+		ExpiresAt: time.Now().Add(params.Duration).UTC(),
+	})
+}
+
+// Lift every mute on a user, or on a guest's name if the user id is 0. Returns an error if they weren't muted.
+func Unmute(ctx context.Context, queries *db.Queries, userId int64, username string) error {
+	params := db.DeleteMutesMatchingParams{UserID: sql.NullInt64{Int64: userId, Valid: userId != 0}}
+	if userId == 0 {
+		params.Username = strings.ToLower(username)
+	}
+
+	deleted, err := queries.DeleteMutesMatching(ctx, params)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return fmt.Errorf("%s is not muted", username)
+	}
+	return nil
+}
+
+// Get how much longer a user is muted for, or a guest if the user id is 0, and 0 if they aren't. Guests are muted if
+// their name or their address is.
+func MuteRemaining(ctx context.Context, queries *db.Queries, userId int64, username string, ip string) (time.Duration, error) {
+	params := db.ListActiveMutesMatchingParams{
+		ExpiresAt: time.Now().UTC(),
+		UserID:    sql.NullInt64{Int64: userId, Valid: userId != 0},
+	}
+	if userId == 0 {
+		params.Username = strings.ToLower(username)
+		params.Ip = sql.NullString{String: ip, Valid: ip != ""}
+	}
+
+	mutes, err := queries.ListActiveMutesMatching(ctx, params)
+	if err != nil {
+		return 0, err
+	}
+
+	var remaining time.Duration
+	for _, mute := range mutes {
+		remaining = max(remaining, time.Until(mute.ExpiresAt))
+	}
+	return remaining, nil
+}
+
+// When a client's player may chat again, so chatting doesn't ask the database every time. It's looked up when the
+// player logs in and kept up to date by the mute command. Safe to use from any goroutine.
+type MuteStatus struct {
+	until time.Time
+	mux   sync.Mutex
+}
+
+func (m *MuteStatus) Set(until time.Time) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	m.until = until
+}
+
+// How much longer the player is muted for, 0 if they aren't
+func (m *MuteStatus) Remaining() time.Duration {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	return max(time.Until(m.until), 0)
+}
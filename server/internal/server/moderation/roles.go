@@ -0,0 +1,32 @@
+package moderation
+
+import "fmt"
+
+// What a user is allowed to do, stored in the role column of the users table
+type Role string
+
+const (
+	RolePlayer    Role = "player"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+var roleRanks = map[Role]int{
+	RolePlayer:    0,
+	RoleModerator: 1,
+	RoleAdmin:     2,
+}
+
+// Parse a role name, returns an error for unknown roles
+func ParseRole(name string) (Role, error) {
+	role := Role(name)
+	if _, ok := roleRanks[role]; !ok {
+		return "", fmt.Errorf("unknown role %q, must be one of player, moderator or admin", name)
+	}
+	return role, nil
+}
+
+// Whether the role has at least the privileges of the other role, unknown roles only have player privileges
+func (r Role) AtLeast(other Role) bool {
+	return roleRanks[r] >= roleRanks[other]
+}
@@ -0,0 +1,143 @@
+package names
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Usernames and player names must be between these lengths
+const (
+	MinLength = 3
+	MaxLength = 20
+)
+
+// Names nobody may play as, since players could pretend to speak for the server or its staff
+var reserved = map[string]struct{}{
+	"admin":         {},
+	"administrator": {},
+	"moderator":     {},
+	"mod":           {},
+	"server":        {},
+	"system":        {},
+}
+
+// The form names are compared and stored in, so "Bob" and "bob" are the same player
+func Normalize(name string) string {
+	return strings.ToLower(name)
+}
+
+func IsReserved(name string) bool {
+	_, isReserved := reserved[Normalize(name)]
+	return isReserved
+}
+
+// Keeps track of which client plays under which name, so no two players share one. A client may hold several names,
+// e.g. a registered user holds their username as well as the display name they play under.
+type Registry struct {
+	// Keyed by the normalized names
+	owners map[string]uint64
+	// The names as they were claimed, in that order
+	byClient map[uint64][]string
+	mux      sync.Mutex
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		owners:   make(map[string]uint64),
+		byClient: make(map[uint64][]string),
+	}
+}
+
+// Claim a name for a client, returns false if another client is using it
+func (r *Registry) Claim(name string, clientId uint64) bool {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return r.claim(name, clientId)
+}
+
+// Claim the name a guest asked for, or the first free one made by adding a number to it if it's taken by another
+// player or a registered user goes by it. The registered names are looked up without holding the lock, so other
+// clients aren't kept waiting on the database.
+func (r *Registry) ClaimGuest(name string, clientId uint64, isRegistered func(name string) (bool, error)) (string, error) {
+	for suffix := 1; suffix < 1000; suffix++ {
+		candidate := name
+		if suffix > 1 {
+			number := strconv.Itoa(suffix)
+			candidate = name[:min(len(name), MaxLength-len(number))] + number
+		}
+
+		if IsReserved(candidate) {
+			continue
+		}
+		if owner, inUse := r.Owner(candidate); inUse && owner != clientId {
+			continue
+		}
+		registered, err := isRegistered(Normalize(candidate))
+		if err != nil {
+			return "", err
+		}
+		// Another client may have claimed the name while it was being looked up
+		if !registered && r.Claim(candidate, clientId) {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("no free name like %s", name)
+}
+
+// Free the names a client was using
+func (r *Registry) Release(clientId uint64) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	for _, name := range r.byClient[clientId] {
+		delete(r.owners, Normalize(name))
+	}
+	delete(r.byClient, clientId)
+}
+
+// Whether any client is using a name
+func (r *Registry) InUse(name string) bool {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	_, inUse := r.owners[Normalize(name)]
+	return inUse
+}
+
+// The client using a name
+func (r *Registry) Owner(name string) (uint64, bool) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	owner, inUse := r.owners[Normalize(name)]
+	return owner, inUse
+}
+
+// The name a client plays under, which is the one it claimed last
+func (r *Registry) Name(clientId uint64) (string, bool) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	claimed := r.byClient[clientId]
+	if len(claimed) == 0 {
+		return "", false
+	}
+	return claimed[len(claimed)-1], true
+}
+
+func (r *Registry) claim(name string, clientId uint64) bool {
+	key := Normalize(name)
+	if owner, exists := r.owners[key]; exists {
+		if owner != clientId {
+			return false
+		}
+		// Claiming a name again, maybe spelled differently, makes it the one the client plays under
+		claimed := slices.DeleteFunc(r.byClient[clientId], func(other string) bool { return Normalize(other) == key })
+		r.byClient[clientId] = append(claimed, name)
+		return true
+	}
+
+	r.owners[key] = clientId
+	r.byClient[clientId] = append(r.byClient[clientId], name)
+	return true
+}
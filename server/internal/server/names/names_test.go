@@ -0,0 +1,97 @@
+package names
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	if Normalize("BoB") != Normalize("bob") {
+		t.Error("expected names differing only in case to be the same")
+	}
+	if Normalize("bob") == Normalize("bob2") {
+		t.Error("expected different names to stay different")
+	}
+}
+
+func TestIsReserved(t *testing.T) {
+	for _, name := range []string{"admin", "Admin", "MODERATOR", "System"} {
+		if !IsReserved(name) {
+			t.Errorf("expected %q to be reserved", name)
+		}
+	}
+	for _, name := range []string{"bob", "admins", "mod1"} {
+		if IsReserved(name) {
+			t.Errorf("expected %q not to be reserved", name)
+		}
+	}
+}
+
+func TestClaim(t *testing.T) {
+	r := NewRegistry()
+	if !r.Claim("Bob", 1) {
+		t.Fatal("expected a free name to be claimed")
+	}
+	if r.Claim("bob", 2) {
+		t.Error("expected another client not to claim the name in another case")
+	}
+	if owner, inUse := r.Owner("BOB"); !inUse || owner != 1 {
+		t.Errorf("expected the name to belong to client 1, got %d (in use: %t)", owner, inUse)
+	}
+
+	// The name claimed last is the one played under, claiming an old one again brings it back
+	r.Claim("Robert", 1)
+	if name, _ := r.Name(1); name != "Robert" {
+		t.Errorf("expected client 1 to play as Robert, got %q", name)
+	}
+	r.Claim("BOB", 1)
+	if name, _ := r.Name(1); name != "BOB" {
+		t.Errorf("expected client 1 to play as BOB, got %q", name)
+	}
+
+	r.Release(1)
+	if r.InUse("bob") || r.InUse("robert") {
+		t.Error("expected the names to be free after the release")
+	}
+	if _, exists := r.Name(1); exists {
+		t.Error("expected the released client not to have a name")
+	}
+	if !r.Claim("bob", 2) {
+		t.Error("expected the released name to be claimed by another client")
+	}
+}
+
+func TestClaimGuest(t *testing.T) {
+	r := NewRegistry()
+	r.Claim("guest", 1)
+	registered := map[string]bool{"guest2": true}
+	isRegistered := func(name string) (bool, error) { return registered[name], nil }
+
+	// Taken by a player and a registered user
+	if name, err := r.ClaimGuest("Guest", 2, isRegistered); err != nil || name != "Guest3" {
+		t.Errorf("expected Guest3, got %q (%v)", name, err)
+	}
+	// A guest may keep the name they already hold
+	if name, err := r.ClaimGuest("guest", 1, isRegistered); err != nil || name != "guest" {
+		t.Errorf("expected guest to keep their name, got %q (%v)", name, err)
+	}
+	// Reserved names get a number too
+	if name, err := r.ClaimGuest("admin", 3, isRegistered); err != nil || name != "admin2" {
+		t.Errorf("expected admin2, got %q (%v)", name, err)
+	}
+	// The number replaces the end of long names
+	long := strings.Repeat("a", MaxLength)
+	r.Claim(long, 4)
+	if name, err := r.ClaimGuest(long, 5, isRegistered); err != nil || name != long[:MaxLength-1]+"2" {
+		t.Errorf("expected the name to stay %d long, got %q (%v)", MaxLength, name, err)
+	}
+
+	failure := errors.New("database is down")
+	if _, err := r.ClaimGuest("someone", 6, func(string) (bool, error) { return false, failure }); !errors.Is(err, failure) {
+		t.Errorf("expected the lookup error, got %v", err)
+	}
+	if r.InUse("someone") {
+		t.Error("expected the name not to be claimed when the lookup fails")
+	}
+}
@@ -0,0 +1,74 @@
+package objects
+
+import (
+	"sync"
+	"time"
+)
+
+// The kinds of power-ups, the values match the PowerUpKind enum in the packets
+type PowerUpKind int32
+
+const (
+	PowerUpSpeed PowerUpKind = iota + 1
+	PowerUpShield
+	PowerUpMagnet
+	PowerUpMassMultiplier
+)
+
+var PowerUpKinds = []PowerUpKind{PowerUpSpeed, PowerUpShield, PowerUpMagnet, PowerUpMassMultiplier}
+
+// A thread-safe set of power-up effects active on a player, the zero value has no effects
+type Effects struct {
+	expires map[PowerUpKind]time.Time
+	mux     sync.Mutex
+}
+
+// Activate an effect for the given duration, picking up the same power-up again resets its timer
+func (e *Effects) Add(kind PowerUpKind, duration time.Duration) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	if e.expires == nil {
+		e.expires = make(map[PowerUpKind]time.Time)
+	}
+	e.expires[kind] = time.Now().Add(duration)
+}
+
+// Whether an effect is currently active
+func (e *Effects) Active(kind PowerUpKind) bool {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	expires, ok := e.expires[kind]
+	return ok && time.Now().Before(expires)
+}
+
+// Remove the effects that wore off and report whether there were any
+func (e *Effects) Expire() bool {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	expired := false
+	now := time.Now()
+	for kind, expires := range e.expires {
+		if !now.Before(expires) {
+			delete(e.expires, kind)
+			expired = true
+		}
+	}
+	return expired
+}
+
+// Get the time left on each active effect
+func (e *Effects) Remaining() map[PowerUpKind]time.Duration {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	remaining := make(map[PowerUpKind]time.Duration, len(e.expires))
+	for kind, expires := range e.expires {
+		if left := time.Until(expires); left > 0 {
+			remaining[kind] = left
+		}
+	}
+	return remaining
+}
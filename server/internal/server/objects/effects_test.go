@@ -0,0 +1,75 @@
+package objects
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEffectsZeroValue(t *testing.T) {
+	var effects Effects
+	for _, kind := range PowerUpKinds {
+		if effects.Active(kind) {
+			t.Errorf("expected power-up %d not to be active", kind)
+		}
+	}
+	if effects.Expire() {
+		t.Error("expected nothing to expire")
+	}
+	if remaining := effects.Remaining(); len(remaining) != 0 {
+		t.Errorf("expected no effects, got %v", remaining)
+	}
+}
+
+func TestEffectsStack(t *testing.T) {
+	var effects Effects
+	effects.Add(PowerUpMagnet, time.Minute)
+	effects.Add(PowerUpSpeed, time.Minute)
+
+	if !effects.Active(PowerUpMagnet) || !effects.Active(PowerUpSpeed) {
+		t.Error("expected the magnet and speed to be active together")
+	}
+	if effects.Active(PowerUpShield) {
+		t.Error("expected the shield not to be active")
+	}
+	if remaining := effects.Remaining(); len(remaining) != 2 {
+		t.Errorf("expected 2 effects, got %v", remaining)
+	}
+}
+
+func TestEffectsResetTimer(t *testing.T) {
+	var effects Effects
+	effects.Add(PowerUpSpeed, time.Second)
+	effects.Add(PowerUpSpeed, time.Minute)
+
+	if left := effects.Remaining()[PowerUpSpeed]; left <= time.Second {
+		t.Errorf("expected picking up the same power-up again to reset its timer, got %s left", left)
+	}
+
+	// Picking it up again resets the timer, even to a shorter one
+	effects.Add(PowerUpSpeed, time.Second)
+	if left := effects.Remaining()[PowerUpSpeed]; left > time.Second {
+		t.Errorf("expected at most a second left, got %s", left)
+	}
+}
+
+func TestEffectsExpire(t *testing.T) {
+	var effects Effects
+	effects.Add(PowerUpMagnet, -time.Second)
+	effects.Add(PowerUpSpeed, time.Minute)
+
+	if effects.Active(PowerUpMagnet) {
+		t.Error("expected the magnet to have worn off")
+	}
+	if _, listed := effects.Remaining()[PowerUpMagnet]; listed {
+		t.Error("expected the magnet not to be listed once it wore off")
+	}
+	if !effects.Expire() {
+		t.Error("expected the magnet to expire")
+	}
+	if effects.Expire() {
+		t.Error("expected the magnet to expire only once")
+	}
+	if !effects.Active(PowerUpSpeed) {
+		t.Error("expected the speed to still be active")
+	}
+}
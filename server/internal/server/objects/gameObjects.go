@@ -1,5 +1,7 @@
 package objects
 
+import "time"
+
 type Player struct {
 	Name      string
 	X         float64
@@ -8,6 +10,9 @@ type Player struct {
 	Direction float64
 	Speed     float64
 	Color     int32
+	Team      int32
+	SkinId    uint32
+	Effects   Effects
 }
 
 type Spore struct {
@@ -15,3 +20,12 @@ type Spore struct {
 	Y      float64
 	Radius float64
 }
+
+type PowerUp struct {
+	X      float64
+	Y      float64
+	Radius float64
+	Kind   PowerUpKind
+	// How long the effect lasts once picked up
+	Duration time.Duration
+}
@@ -49,6 +49,17 @@ func (c *SharedCollection[T]) Remove(id uint64) {
 	delete(c.objects, id)   // Remove the object from the map if it exists
 }
 
+// Swap the object with the given ID for another one, returns false and adds nothing if there is no such object
+func (c *SharedCollection[T]) Replace(id uint64, obj T) bool {
+	c.mapMux.Lock()         // Lock the map so we can safely replace the object
+	defer c.mapMux.Unlock() // Unlock the map when we're done
+	if _, exists := c.objects[id]; !exists {
+		return false
+	}
+	c.objects[id] = obj
+	return true
+}
+
 // Call the given function for each object in the collection
 func (c *SharedCollection[T]) ForEach(f func(uint64, T)) {
 	c.mapMux.Lock()                                 // Lock the map so we can safely iterate over the objects
@@ -74,3 +85,10 @@ func (c *SharedCollection[T]) Get(id uint64) (T, bool) {
 func (c *SharedCollection[T]) Len() int {
 	return len(c.objects)
 }
+
+// Remove all objects from the collection
+func (c *SharedCollection[T]) Clear() {
+	c.mapMux.Lock()         // Lock the map so we can safely clear it
+	defer c.mapMux.Unlock() // Unlock the map when we're done
+	clear(c.objects)
+}
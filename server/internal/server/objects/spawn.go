@@ -1,6 +1,9 @@
 package objects
 
-import "math/rand/v2"
+import (
+	"math"
+	"math/rand/v2"
+)
 
 var getPlayerPosition = func(p *Player) (float64, float64) { return p.X, p.Y }
 var getPlayerRadius = func(p *Player) float64 { return p.Radius }
@@ -60,3 +63,22 @@ func SpawnCoords(radius float64, playersToAvoid *SharedCollection[*Player], spor
 		}
 	}
 }
+
+// SpawnCoordsNear finds a free spot around the given coordinates, at the given distance from them give or take half
+// of it. If there's no room around them it falls back to SpawnCoords.
+func SpawnCoordsNear(x float64, y float64, distance float64, radius float64, playersToAvoid *SharedCollection[*Player], sporesToAvoid *SharedCollection[*Spore]) (float64, float64) {
+	const maxTries int = 25
+
+	for tries := 0; tries < maxTries; tries++ {
+		angle := 2 * math.Pi * rand.Float64()
+		dist := distance * (0.5 + rand.Float64())
+		candidateX := x + dist*math.Cos(angle)
+		candidateY := y + dist*math.Sin(angle)
+
+		if !isTooClose(candidateX, candidateY, radius, playersToAvoid, getPlayerPosition, getPlayerRadius) &&
+			!isTooClose(candidateX, candidateY, radius, sporesToAvoid, getSporePosition, getSporeRadius) {
+			return candidateX, candidateY
+		}
+	}
+	return SpawnCoords(radius, playersToAvoid, sporesToAvoid)
+}
@@ -0,0 +1,249 @@
+package server
+
+import (
+	"errors"
+	"server/pkg/packets"
+	"slices"
+	"sync"
+)
+
+var (
+	ErrInParty     = errors.New("already in a party")
+	ErrNotInParty  = errors.New("not in a party")
+	ErrNotLeader   = errors.New("only the party leader can do that")
+	ErrPartyFull   = errors.New("the party is full")
+	ErrNotInvited  = errors.New("no invitation to that party")
+	ErrUnknownRoom = errors.New("there is no such room")
+)
+
+type PartyMember struct {
+	ClientId uint64
+	Name     string
+}
+
+// Players who play together: they're put in the same room, and on the same team in team mode
+type Party struct {
+	Id     uint64
+	Leader uint64
+	// In the order they joined, the leader is the first
+	Members []PartyMember
+	// Chosen by the leader
+	Room    string
+	invited map[uint64]struct{}
+}
+
+// The client ids of the members other than the given one
+func (p Party) Mates(clientId uint64) []uint64 {
+	mates := make([]uint64, 0, len(p.Members))
+	for _, member := range p.Members {
+		if member.ClientId != clientId {
+			mates = append(mates, member.ClientId)
+		}
+	}
+	return mates
+}
+
+// Keeps track of the parties players formed. Parties only live in memory and are gone once their last member leaves.
+type Parties struct {
+	byId     map[uint64]*Party
+	byClient map[uint64]uint64
+	nextId   uint64
+	maxSize  int
+	// The rooms a leader can choose from
+	rooms func() []string
+	mux   sync.Mutex
+}
+
+func NewParties(maxSize int, rooms func() []string) *Parties {
+	return &Parties{
+		byId:     make(map[uint64]*Party),
+		byClient: make(map[uint64]uint64),
+		maxSize:  maxSize,
+		rooms:    rooms,
+	}
+}
+
+// Start a party led by the client, in the given room
+func (p *Parties) Create(leader PartyMember, room string) (Party, error) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	if _, exists := p.byClient[leader.ClientId]; exists {
+		return Party{}, ErrInParty
+	}
+
+	p.nextId++
+	party := &Party{
+		Id:      p.nextId,
+		Leader:  leader.ClientId,
+		Members: []PartyMember{leader},
+		Room:    room,
+		invited: make(map[uint64]struct{}),
+	}
+	p.byId[party.Id] = party
+	p.byClient[leader.ClientId] = party.Id
+	return party.copy(), nil
+}
+
+// Let another client join the leader's party
+func (p *Parties) Invite(leader uint64, invitee uint64) (Party, error) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	party, err := p.led(leader)
+	if err != nil {
+		return Party{}, err
+	}
+	if len(party.Members) >= p.maxSize {
+		return Party{}, ErrPartyFull
+	}
+
+	party.invited[invitee] = struct{}{}
+	return party.copy(), nil
+}
+
+// Join a party the client was invited to
+func (p *Parties) Accept(member PartyMember, partyId uint64) (Party, error) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	if _, exists := p.byClient[member.ClientId]; exists {
+		return Party{}, ErrInParty
+	}
+	party, exists := p.byId[partyId]
+	if !exists {
+		return Party{}, ErrNotInvited
+	}
+	if _, invited := party.invited[member.ClientId]; !invited {
+		return Party{}, ErrNotInvited
+	}
+	if len(party.Members) >= p.maxSize {
+		return Party{}, ErrPartyFull
+	}
+
+	delete(party.invited, member.ClientId)
+	party.Members = append(party.Members, member)
+	p.byClient[member.ClientId] = party.Id
+	return party.copy(), nil
+}
+
+// Take the client out of their party. The next member to have joined takes over if the leader leaves. Returns the
+// party as it is now, with no members if it was dissolved.
+func (p *Parties) Leave(clientId uint64) (Party, error) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	return p.remove(clientId)
+}
+
+// Throw a member out of the leader's party, returns the party without them
+func (p *Parties) Kick(leader uint64, member uint64) (Party, error) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	party, err := p.led(leader)
+	if err != nil {
+		return Party{}, err
+	}
+	if member == leader || p.byClient[member] != party.Id {
+		return Party{}, ErrNotInParty
+	}
+	return p.remove(member)
+}
+
+// Choose the room the leader's party plays in
+func (p *Parties) SetRoom(leader uint64, room string) (Party, error) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	party, err := p.led(leader)
+	if err != nil {
+		return Party{}, err
+	}
+	if !slices.Contains(p.rooms(), room) {
+		return Party{}, ErrUnknownRoom
+	}
+
+	party.Room = room
+	return party.copy(), nil
+}
+
+// The party the client is in
+func (p *Parties) Get(clientId uint64) (Party, bool) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	partyId, exists := p.byClient[clientId]
+	if !exists {
+		return Party{}, false
+	}
+	return p.byId[partyId].copy(), true
+}
+
+func (p *Parties) led(leader uint64) (*Party, error) {
+	partyId, exists := p.byClient[leader]
+	if !exists {
+		return nil, ErrNotInParty
+	}
+	party := p.byId[partyId]
+	if party.Leader != leader {
+		return nil, ErrNotLeader
+	}
+	return party, nil
+}
+
+func (p *Parties) remove(clientId uint64) (Party, error) {
+	partyId, exists := p.byClient[clientId]
+	if !exists {
+		return Party{}, ErrNotInParty
+	}
+	delete(p.byClient, clientId)
+
+	party := p.byId[partyId]
+	party.Members = slices.DeleteFunc(party.Members, func(member PartyMember) bool {
+		return member.ClientId == clientId
+	})
+	if len(party.Members) == 0 {
+		delete(p.byId, partyId)
+		return party.copy(), nil
+	}
+
+	if party.Leader == clientId {
+		party.Leader = party.Members[0].ClientId
+	}
+	return party.copy(), nil
+}
+
+// Parties are handed out by value so they can be used without holding the lock
+func (p *Party) copy() Party {
+	party := *p
+	party.Members = slices.Clone(p.Members)
+	party.invited = nil
+	return party
+}
+
+// Let every member of a party know what it looks like now
+func SendParty(client ClientInterfacer, party Party) {
+	message := NewPartyMessage(party)
+	for _, member := range party.Members {
+		if peer, exists := client.Peer(member.ClientId); exists {
+			peer.SocketSend(message)
+		}
+	}
+}
+
+func NewPartyMessage(party Party) packets.Msg {
+	members := make([]*packets.PartyMemberMessage, len(party.Members))
+	for i, member := range party.Members {
+		members[i] = &packets.PartyMemberMessage{Id: member.ClientId, Name: member.Name}
+	}
+	return packets.NewParty(party.Id, party.Leader, members, party.Room)
+}
+
+// Take a client who's leaving the game out of their party, letting the rest know
+func (h *Hub) LeaveParty(client ClientInterfacer) {
+	party, err := h.Parties.Leave(client.Id())
+	if err != nil {
+		return
+	}
+	SendParty(client, party)
+}
@@ -0,0 +1,164 @@
+package server
+
+import (
+	"errors"
+	"testing"
+)
+
+func newTestParties(maxSize int) *Parties {
+	return NewParties(maxSize, func() []string { return []string{"main", "other"} })
+}
+
+func memberIds(party Party) []uint64 {
+	ids := make([]uint64, len(party.Members))
+	for i, member := range party.Members {
+		ids[i] = member.ClientId
+	}
+	return ids
+}
+
+func TestPartyInviteAndAccept(t *testing.T) {
+	parties := newTestParties(3)
+	party, err := parties.Create(PartyMember{ClientId: 1, Name: "alice"}, "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parties.Create(PartyMember{ClientId: 1, Name: "alice"}, "main"); !errors.Is(err, ErrInParty) {
+		t.Errorf("expected a member not to start another party, got %v", err)
+	}
+
+	if _, err := parties.Accept(PartyMember{ClientId: 2, Name: "bob"}, party.Id); !errors.Is(err, ErrNotInvited) {
+		t.Errorf("expected joining without an invitation to fail, got %v", err)
+	}
+	if _, err := parties.Invite(2, 3); !errors.Is(err, ErrNotInParty) {
+		t.Errorf("expected someone outside a party not to invite, got %v", err)
+	}
+	if _, err := parties.Invite(1, 2); err != nil {
+		t.Fatal(err)
+	}
+	party, err = parties.Accept(PartyMember{ClientId: 2, Name: "bob"}, party.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parties.Invite(2, 3); !errors.Is(err, ErrNotLeader) {
+		t.Errorf("expected only the leader to invite, got %v", err)
+	}
+	if _, err := parties.Accept(PartyMember{ClientId: 2, Name: "bob"}, party.Id); !errors.Is(err, ErrInParty) {
+		t.Errorf("expected an invitation to be used once, got %v", err)
+	}
+
+	if mates := party.Mates(2); len(mates) != 1 || mates[0] != 1 {
+		t.Errorf("expected bob's mate to be alice, got %v", mates)
+	}
+	if got, _ := parties.Get(2); got.Id != party.Id || got.Leader != 1 {
+		t.Errorf("expected bob to be in alice's party, got %+v", got)
+	}
+}
+
+func TestPartyFull(t *testing.T) {
+	parties := newTestParties(2)
+	party, _ := parties.Create(PartyMember{ClientId: 1, Name: "alice"}, "main")
+	parties.Invite(1, 2)
+	parties.Invite(1, 3)
+	parties.Accept(PartyMember{ClientId: 2, Name: "bob"}, party.Id)
+
+	if _, err := parties.Invite(1, 4); !errors.Is(err, ErrPartyFull) {
+		t.Errorf("expected no more invitations to a full party, got %v", err)
+	}
+	if _, err := parties.Accept(PartyMember{ClientId: 3, Name: "carol"}, party.Id); !errors.Is(err, ErrPartyFull) {
+		t.Errorf("expected an earlier invitation not to get into a full party, got %v", err)
+	}
+}
+
+func TestPartyLeaderHandoff(t *testing.T) {
+	parties := newTestParties(4)
+	party, _ := parties.Create(PartyMember{ClientId: 1, Name: "alice"}, "main")
+	for _, member := range []PartyMember{{2, "bob"}, {3, "carol"}} {
+		parties.Invite(1, member.ClientId)
+		parties.Accept(member, party.Id)
+	}
+
+	// A member leaving keeps the leader
+	party, err := parties.Leave(3)
+	if err != nil || party.Leader != 1 || len(party.Members) != 2 {
+		t.Fatalf("expected alice to still lead alice and bob, got %+v (%v)", party, err)
+	}
+	if _, inParty := parties.Get(3); inParty {
+		t.Error("expected carol not to be in a party anymore")
+	}
+
+	// The leader leaving hands the party to the next member to have joined
+	party, _ = parties.Leave(1)
+	if party.Leader != 2 || len(party.Members) != 1 {
+		t.Errorf("expected bob to take over, got %+v", party)
+	}
+	if _, err := parties.Invite(2, 4); err != nil {
+		t.Errorf("expected the new leader to invite, got %v", err)
+	}
+}
+
+func TestPartyKick(t *testing.T) {
+	parties := newTestParties(4)
+	party, _ := parties.Create(PartyMember{ClientId: 1, Name: "alice"}, "main")
+	parties.Invite(1, 2)
+	parties.Accept(PartyMember{ClientId: 2, Name: "bob"}, party.Id)
+
+	if _, err := parties.Kick(2, 1); !errors.Is(err, ErrNotLeader) {
+		t.Errorf("expected only the leader to kick, got %v", err)
+	}
+	if _, err := parties.Kick(1, 1); !errors.Is(err, ErrNotInParty) {
+		t.Errorf("expected the leader not to kick themselves, got %v", err)
+	}
+	if _, err := parties.Kick(1, 5); !errors.Is(err, ErrNotInParty) {
+		t.Errorf("expected only members to be kicked, got %v", err)
+	}
+	party, err := parties.Kick(1, 2)
+	if err != nil || len(party.Members) != 1 {
+		t.Errorf("expected bob to be kicked, got %+v (%v)", party, err)
+	}
+}
+
+func TestPartyDisband(t *testing.T) {
+	parties := newTestParties(4)
+	party, _ := parties.Create(PartyMember{ClientId: 1, Name: "alice"}, "main")
+	parties.Invite(1, 2)
+	parties.Accept(PartyMember{ClientId: 2, Name: "bob"}, party.Id)
+
+	parties.Leave(1)
+	party, err := parties.Leave(2)
+	if err != nil || len(party.Members) != 0 {
+		t.Fatalf("expected the party to be dissolved, got %+v (%v)", party, err)
+	}
+	if _, err := parties.Leave(2); !errors.Is(err, ErrNotInParty) {
+		t.Errorf("expected a dissolved party to be gone, got %v", err)
+	}
+
+	// Invitations to a dissolved party can't be used anymore
+	parties.Invite(1, 3)
+	if _, err := parties.Accept(PartyMember{ClientId: 3, Name: "carol"}, party.Id); !errors.Is(err, ErrNotInvited) {
+		t.Errorf("expected the invitation to be gone with the party, got %v", err)
+	}
+	if again, _ := parties.Create(PartyMember{ClientId: 2, Name: "bob"}, "main"); again.Id == party.Id {
+		t.Error("expected a new party to get a new id")
+	}
+}
+
+func TestPartyRoom(t *testing.T) {
+	parties := newTestParties(4)
+	party, _ := parties.Create(PartyMember{ClientId: 1, Name: "alice"}, "main")
+	parties.Invite(1, 2)
+	party, _ = parties.Accept(PartyMember{ClientId: 2, Name: "bob"}, party.Id)
+
+	if _, err := parties.SetRoom(1, "nowhere"); !errors.Is(err, ErrUnknownRoom) {
+		t.Errorf("expected an unknown room to be refused, got %v", err)
+	}
+	if _, err := parties.SetRoom(2, "other"); !errors.Is(err, ErrNotLeader) {
+		t.Errorf("expected only the leader to choose the room, got %v", err)
+	}
+	if party, err := parties.SetRoom(1, "other"); err != nil || party.Room != "other" {
+		t.Errorf("expected the party to move to the other room, got %+v (%v)", party, err)
+	}
+	if ids := memberIds(party); len(ids) != 2 || ids[0] != 1 || ids[1] != 2 {
+		t.Errorf("expected the members in the order they joined, got %v", ids)
+	}
+}
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"log"
+	"server/internal/server/storage"
+	"server/pkg/packets"
+	"sync"
+)
+
+// Implemented by the states of clients playing as a user, so their friends can see what they're doing. Guests'
+// states return 0.
+type UserStateHandler interface {
+	ClientStateHandler
+	UserId() int64
+}
+
+// What a registered user is doing right now
+type PresenceEntry struct {
+	ClientId uint64
+	Room     string
+	// The name of the state the user's client is in
+	Status string
+}
+
+// Keeps track of which registered users are online and what they're doing
+type Presence struct {
+	byUser   map[int64]PresenceEntry
+	byClient map[uint64]int64
+	mux      sync.Mutex
+}
+
+func NewPresence() *Presence {
+	return &Presence{
+		byUser:   make(map[int64]PresenceEntry),
+		byClient: make(map[uint64]int64),
+	}
+}
+
+// Record what a user is doing, returns false if that's what they were doing already
+func (p *Presence) Set(userId int64, entry PresenceEntry) bool {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	if current, exists := p.byUser[userId]; exists && current == entry {
+		return false
+	}
+	p.byUser[userId] = entry
+	p.byClient[entry.ClientId] = userId
+	return true
+}
+
+// Take the user logged in on a client offline, returns their id or 0 if there was nobody
+func (p *Presence) Remove(clientId uint64) int64 {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	userId, exists := p.byClient[clientId]
+	if !exists {
+		return 0
+	}
+	delete(p.byClient, clientId)
+	if p.byUser[userId].ClientId == clientId {
+		delete(p.byUser, userId)
+	}
+	return userId
+}
+
+func (p *Presence) Get(userId int64) (PresenceEntry, bool) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	entry, online := p.byUser[userId]
+	return entry, online
+}
+
+// Keep track of what the user on a client is doing after it entered a new state, letting their friends know
+func (h *Hub) UpdatePresence(client ClientInterfacer, state ClientStateHandler) {
+	var userId int64
+	if userState, ok := state.(UserStateHandler); ok {
+		userId = userState.UserId()
+	}
+
+	if userId == 0 {
+		if userId = h.Presence.Remove(client.Id()); userId != 0 {
+			h.pushPresence(client, userId)
+		}
+		return
+	}
+
+	entry := PresenceEntry{ClientId: client.Id(), Room: client.Room().Name, Status: state.Name()}
+	if h.Presence.Set(userId, entry) {
+		h.pushPresence(client, userId)
+	}
+}
+
+// Tell the user's friends who are online what the user is doing now. The friends are looked up by a worker, so
+// changing state doesn't wait for the database.
+func (h *Hub) pushPresence(client ClientInterfacer, userId int64) {
+	err := h.Workers.Submit(client, func(ctx context.Context) error {
+		user, err := h.users.GetUserByID(ctx, userId)
+		if err != nil {
+			log.Printf("Failed to get user %d to tell their friends about their presence: %v", userId, err)
+			return nil
+		}
+		friends, err := h.users.ListFriendsByUser(ctx, userId)
+		if err != nil {
+			log.Printf("Failed to get the friends of user %d to tell them about their presence: %v", userId, err)
+			return nil
+		}
+
+		// The user may have moved on while the friends were looked up, they get the latest
+		entry, online := h.Presence.Get(userId)
+		message := packets.NewFriend(user.Username, packets.FriendshipState_FRIENDSHIP_STATE_FRIENDS, online, entry.Room, entry.Status)
+		for _, friend := range friends {
+			if friend.Status != storage.FriendshipAccepted {
+				continue
+			}
+			if friendEntry, online := h.Presence.Get(friend.FriendID); online {
+				if peer, exists := h.Clients.Get(friendEntry.ClientId); exists {
+					peer.SocketSend(message)
+				}
+			}
+		}
+		return nil
+	}, nil)
+	if err != nil {
+		log.Printf("Failed to tell the friends of user %d about their presence: %v", userId, err)
+	}
+}
@@ -0,0 +1,52 @@
+package rating
+
+import "math"
+
+// The rating users start with before they played a rated game, and guests always have
+const Initial = 1500.0
+
+// Ratings never drop below this, so a bad streak can't bury a player for good
+const Floor = 100.0
+
+const (
+	// The most a life can move a rating by depending on where the player placed
+	placementK = 32.0
+	// The most eating another player can move a rating by, for each player eaten
+	killK = 8.0
+)
+
+// How a life went, as far as the rating is concerned
+type Outcome struct {
+	// Where the player placed among the players in the room when the life ended, 1 is the top. Players tied with each
+	// other share the places they cover, e.g. two players tied for the top both place 1.5.
+	Placement float64
+	// How many players there were, the player included
+	Players int
+	// The average rating of the other players
+	Field float64
+	// The ratings of the players they ate
+	Kills []float64
+}
+
+// The score a player rated a is expected to get against one rated b: the chance of winning, as Elo has it
+func Expected(a float64, b float64) float64 {
+	return 1 / (1 + math.Pow(10, (b-a)/400))
+}
+
+// The rating after a life. Placing is scored like a game against the field, 1 for the top and 0 for the bottom, and
+// every player eaten like a win against them, so upsets count for more than beating weaker players.
+func Update(rating float64, outcome Outcome) float64 {
+	change := 0.0
+	if outcome.Players > 1 {
+		change += placementK * (placementScore(outcome.Placement, outcome.Players) - Expected(rating, outcome.Field))
+	}
+	for _, kill := range outcome.Kills {
+		change += killK * (1 - Expected(rating, kill))
+	}
+	return max(rating+change, Floor)
+}
+
+func placementScore(placement float64, players int) float64 {
+	placement = min(max(placement, 1), float64(players))
+	return (float64(players) - placement) / float64(players-1)
+}
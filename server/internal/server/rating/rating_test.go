@@ -0,0 +1,61 @@
+package rating
+
+import (
+	"math"
+	"testing"
+)
+
+func TestExpected(t *testing.T) {
+	if e := Expected(1500, 1500); e != 0.5 {
+		t.Errorf("expected 0.5 between equal ratings, got %f", e)
+	}
+	if e := Expected(1900, 1500); math.Abs(e-10.0/11) > 1e-9 {
+		t.Errorf("expected 10/11 for 400 points more, got %f", e)
+	}
+	if sum := Expected(1234, 1789) + Expected(1789, 1234); math.Abs(sum-1) > 1e-9 {
+		t.Errorf("expected scores of both sides should add up to 1, got %f", sum)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rating  float64
+		outcome Outcome
+		want    float64
+	}{
+		{"top of an even field", 1500, Outcome{Placement: 1, Players: 5, Field: 1500}, 1516},
+		{"bottom of an even field", 1500, Outcome{Placement: 5, Players: 5, Field: 1500}, 1484},
+		{"middle of an even field", 1500, Outcome{Placement: 3, Players: 5, Field: 1500}, 1500},
+		{"tied in an even field", 1500, Outcome{Placement: 1.5, Players: 2, Field: 1500}, 1500},
+		{"alone without kills", 1500, Outcome{Placement: 1, Players: 1, Field: 1500}, 1500},
+		{"placement out of range", 1500, Outcome{Placement: 0, Players: 3, Field: 1500}, 1516},
+		{"kill of an equal", 1500, Outcome{Placement: 1, Players: 1, Kills: []float64{1500}}, 1504},
+		{"kills of equals", 1500, Outcome{Placement: 1, Players: 1, Kills: []float64{1500, 1500}}, 1508},
+		{"bottom at the floor", Floor, Outcome{Placement: 2, Players: 2, Field: Floor}, Floor},
+	}
+
+	for _, test := range tests {
+		if got := Update(test.rating, test.outcome); math.Abs(got-test.want) > 1e-9 {
+			t.Errorf("%s: expected %f, got %f", test.name, test.want, got)
+		}
+	}
+}
+
+func TestUpdateRewardsUpsets(t *testing.T) {
+	strongField := Update(1500, Outcome{Placement: 1, Players: 4, Field: 1800})
+	weakField := Update(1500, Outcome{Placement: 1, Players: 4, Field: 1200})
+	if strongField <= weakField {
+		t.Errorf("winning against a stronger field should gain more: %f vs %f", strongField, weakField)
+	}
+
+	strongKill := Update(1500, Outcome{Kills: []float64{1800}})
+	weakKill := Update(1500, Outcome{Kills: []float64{1200}})
+	if strongKill <= weakKill {
+		t.Errorf("eating a stronger player should gain more: %f vs %f", strongKill, weakKill)
+	}
+
+	if losing := Update(1800, Outcome{Placement: 4, Players: 4, Field: 1500}); 1800-losing <= 16 {
+		t.Errorf("a strong player placing last against a weaker field should lose more than half of K, got %f", losing)
+	}
+}
@@ -0,0 +1,479 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"server/internal/server/matchmaking"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrRoomFull  = errors.New("the room is full")
+	ErrRoomsFull = errors.New("every room is full")
+)
+
+// An arena with a world of its own. Players only see and hear the players in the same room, except for global chat
+// and announcements. Each room runs its world in the background until it's closed.
+type Room struct {
+	Name              string
+	Region            string
+	SharedGameObjects *SharedGameObjects
+	config            Config
+	// Whether the room stays open while nobody plays in it
+	permanent bool
+	// Packets in this channel will be processed by all clients in the room except the sender
+	broadcastChan chan *packets.Packet
+	clients       *objects.SharedCollection[ClientInterfacer]
+	// When the last player left, or a player was placed in the room while it was empty
+	emptySince time.Time
+	mux        sync.Mutex
+	ctx        context.Context
+	cancel     context.CancelFunc
+}
+
+func newRoom(settings RoomConfig, region string, permanent bool, config Config, chat *ChatLog) *Room {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Room{
+		Name:   settings.Name,
+		Region: region,
+		SharedGameObjects: &SharedGameObjects{
+			Players:  objects.NewSharedCollection[*objects.Player](),
+			Spores:   objects.NewSharedCollection[*objects.Spore](),
+			PowerUps: objects.NewSharedCollection[*objects.PowerUp](),
+			Teams:    NewTeams(settings.Teams),
+			Round:    NewRound(config),
+			Chat:     chat,
+		},
+		config:        config,
+		permanent:     permanent,
+		broadcastChan: make(chan *packets.Packet),
+		clients:       objects.NewSharedCollection[ClientInterfacer](),
+		emptySince:    time.Now(),
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+}
+
+// Fill the world and keep it going until the room is closed
+func (r *Room) run() {
+	for i := 0; i < MaxSpores; i++ {
+		r.SharedGameObjects.Spores.Add(r.SharedGameObjects.NewSpore())
+	}
+
+	go r.replenishSporesLoop(5 * time.Second)
+	go r.spawnPowerUpsLoop(r.config.PowerUpSpawnRate)
+
+	if r.SharedGameObjects.Teams.Enabled() {
+		go r.broadcastTeamScoresLoop(2 * time.Second)
+	}
+
+	if r.SharedGameObjects.Round.Enabled() {
+		go r.runRoundsLoop(time.Second)
+	}
+
+	for {
+		select {
+		case packet := <-r.broadcastChan:
+			r.clients.ForEach(func(clientId uint64, client ClientInterfacer) {
+				if clientId != packet.SenderId {
+					client.ProcessMessage(packet.SenderId, packet.Msg)
+				}
+			})
+		case <-r.ctx.Done():
+			return
+		}
+	}
+}
+
+// Have every client in the room except the sender process the packet. Packets sent after the room closed are dropped.
+func (r *Room) Broadcast(packet *packets.Packet) {
+	select {
+	case r.broadcastChan <- packet:
+	case <-r.ctx.Done():
+	}
+}
+
+// Count the client as playing in the room
+func (r *Room) Add(client ClientInterfacer) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.clients.Add(client, client.Id())
+	r.emptySince = time.Time{}
+}
+
+func (r *Room) Remove(clientId uint64) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if _, exists := r.clients.Get(clientId); !exists {
+		return
+	}
+	r.clients.Remove(clientId)
+	if r.clients.Len() == 0 {
+		r.emptySince = time.Now()
+	}
+}
+
+// The number of players in the room, including those who are dead or spectating
+func (r *Room) Len() int {
+	return r.clients.Len()
+}
+
+// Whether there is space for this many more players
+func (r *Room) Fits(players int) bool {
+	return r.Len()+players <= r.config.RoomCapacity
+}
+
+// How long the room has been empty for, 0 if there are players in it
+func (r *Room) idleFor() time.Duration {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if r.emptySince.IsZero() {
+		return 0
+	}
+	return time.Since(r.emptySince)
+}
+
+// Restart the idle timer of an empty room a player is about to join
+func (r *Room) keepOpen() {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if !r.emptySince.IsZero() {
+		r.emptySince = time.Now()
+	}
+}
+
+// What the matchmaker needs to know about the room
+func (r *Room) info() matchmaking.Room {
+	info := matchmaking.Room{
+		Region:   r.Region,
+		Capacity: r.config.RoomCapacity,
+	}
+	r.clients.ForEach(func(_ uint64, client ClientInterfacer) {
+		info.Players++
+		info.Rating += client.Rating()
+	})
+	if info.Players > 0 {
+		info.Rating /= float64(info.Players)
+	}
+	return info
+}
+
+func (r *Room) newPowerUp() *objects.PowerUp {
+	const radius = 15
+	x, y := objects.SpawnCoords(radius, r.SharedGameObjects.Players, r.SharedGameObjects.Spores)
+	return &objects.PowerUp{
+		X:        x,
+		Y:        y,
+		Radius:   radius,
+		Kind:     objects.PowerUpKinds[rand.Intn(len(objects.PowerUpKinds))],
+		Duration: r.config.PowerUpDuration,
+	}
+}
+
+func (r *Room) spawnPowerUpsLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if r.SharedGameObjects.PowerUps.Len() >= r.config.MaxPowerUps {
+			continue
+		}
+
+		powerUp := r.newPowerUp()
+		powerUpId := r.SharedGameObjects.PowerUps.Add(powerUp)
+		r.Broadcast(&packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewPowerUp(powerUpId, powerUp),
+		})
+	}
+}
+
+func (r *Room) replenishSporesLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		sporesRemaining := r.SharedGameObjects.Spores.Len()
+		diff := MaxSpores - sporesRemaining
+
+		if diff <= 0 {
+			continue
+		}
+
+		log.Printf("%d spores remaining in %s, going to replenish %d", sporesRemaining, r.Name, diff)
+		for i := 0; i < min(diff, 10); i++ {
+			spore := r.SharedGameObjects.NewSpore()
+			r.SharedGameObjects.Spores.Add(spore)
+			r.Broadcast(&packets.Packet{
+				SenderId: 0,
+				Msg:      packets.NewSpore(0, spore),
+			})
+
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+}
+
+// Periodically sum up the mass of every team and let all clients in the room know the standings
+func (r *Room) broadcastTeamScoresLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		teams := r.SharedGameObjects.Teams
+		scores := make([]*packets.TeamScoreMessage, teams.Count())
+		for i := range scores {
+			team := int32(i + 1)
+			scores[i] = &packets.TeamScoreMessage{
+				Team:    team,
+				Color:   teams.Color(team),
+				Players: uint32(teams.Size(team)),
+			}
+		}
+
+		r.SharedGameObjects.Players.ForEach(func(_ uint64, player *objects.Player) {
+			if player.Team > 0 && int(player.Team) <= len(scores) {
+				scores[player.Team-1].Mass += math.Pi * player.Radius * player.Radius
+			}
+		})
+
+		r.Broadcast(&packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewTeamScoreboard(scores),
+		})
+	}
+}
+
+// Drive the battle royale round lifecycle, keeping every client in the room up to date with the round and the safe
+// zone
+func (r *Room) runRoundsLoop(rate time.Duration) {
+	round := r.SharedGameObjects.Round
+	r.resetWorld()
+	round.OpenLobby()
+
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		var winnerId uint64
+		var winnerName string
+
+		switch round.Phase() {
+		case packets.RoundPhase_ROUND_PHASE_LOBBY:
+			if round.TimeRemaining() > 0 {
+				break
+			}
+			if players := r.SharedGameObjects.Players.Len(); players < r.config.MinRoundPlayers {
+				log.Printf("Only %d players in the lobby of %s, waiting for %d to start round %d", players, r.Name, r.config.MinRoundPlayers, round.Number())
+				round.ExtendLobby()
+				break
+			}
+			log.Printf("Starting round %d in %s", round.Number(), r.Name)
+			round.Start()
+
+		case packets.RoundPhase_ROUND_PHASE_RUNNING:
+			x, y, radius := round.Zone()
+			r.Broadcast(&packets.Packet{
+				SenderId: 0,
+				Msg:      packets.NewSafeZone(x, y, radius, r.config.ZoneEndRadius),
+			})
+
+			if r.SharedGameObjects.Players.Len() > 1 && round.TimeRemaining() > 0 {
+				break
+			}
+
+			// Either one player is left standing or time ran out, in which case the most massive player wins
+			var winnerRadius float64
+			r.SharedGameObjects.Players.ForEach(func(playerId uint64, player *objects.Player) {
+				if player.Radius > winnerRadius {
+					winnerId, winnerName, winnerRadius = playerId, player.Name, player.Radius
+				}
+			})
+			log.Printf("Round %d in %s won by %q (%d)", round.Number(), r.Name, winnerName, winnerId)
+			round.End()
+
+		case packets.RoundPhase_ROUND_PHASE_ENDED:
+			if round.TimeRemaining() > 0 {
+				continue
+			}
+			r.resetWorld()
+			round.OpenLobby()
+		}
+
+		r.Broadcast(&packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewRoundState(round.Number(), round.Phase(), round.TimeRemaining().Seconds(), winnerId, winnerName),
+		})
+	}
+}
+
+// Start from a fresh set of spores, the players respawn by themselves when they hear a new lobby has opened and are
+// sent the new spores then. Until then they're told to forget the old spores and power-ups.
+func (r *Room) resetWorld() {
+	r.SharedGameObjects.PowerUps.Clear()
+	r.SharedGameObjects.Spores.Clear()
+	for i := 0; i < MaxSpores; i++ {
+		r.SharedGameObjects.Spores.Add(r.SharedGameObjects.NewSpore())
+	}
+	r.Broadcast(&packets.Packet{SenderId: 0, Msg: packets.NewWorldReset()})
+}
+
+// The rooms of a hub. The first room and the configured ones are always open, more are opened when the matchmaker finds no room which suits a
+// player, and closed again once they've been empty for a while.
+type Rooms struct {
+	config Config
+	chat   *ChatLog
+	byName map[string]*Room
+	// How many rooms were opened so far, new rooms are numbered with it
+	opened int
+	mux    sync.Mutex
+}
+
+func NewRooms(config Config, chat *ChatLog) *Rooms {
+	return &Rooms{
+		config: config,
+		chat:   chat,
+		byName: make(map[string]*Room),
+	}
+}
+
+// Open the first room and the other rooms which are always open, then keep closing the rooms nobody played in for a
+// while
+func (r *Rooms) Start() {
+	r.mux.Lock()
+	r.open(RoomConfig{Name: r.config.Room, Teams: r.config.Teams}, r.defaultRegion(), true)
+	for _, settings := range r.config.Rooms {
+		r.open(settings, r.defaultRegion(), true)
+	}
+	r.mux.Unlock()
+
+	go r.closeIdleRoomsLoop(10 * time.Second)
+}
+
+// Find the room the matchmaker thinks suits the player best, opening a new one if none does and there is space for
+// it. The room counts as busy from then on, so it isn't closed before the player gets there.
+func (r *Rooms) Place(player matchmaking.Player) (*Room, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	// Asking for a region the server doesn't have is the same as not asking for one
+	if !slices.Contains(r.config.Regions, player.Region) {
+		player.Region = ""
+	}
+
+	rooms := r.sorted()
+	infos := make([]matchmaking.Room, len(rooms))
+	for i, room := range rooms {
+		infos[i] = room.info()
+	}
+
+	canOpen := len(rooms) < r.config.MaxRooms
+	if i := matchmaking.Pick(infos, player, canOpen); i >= 0 {
+		rooms[i].keepOpen()
+		return rooms[i], nil
+	}
+	if !canOpen {
+		return nil, ErrRoomsFull
+	}
+
+	region := player.Region
+	if region == "" {
+		region = r.defaultRegion()
+	}
+	settings := RoomConfig{Name: fmt.Sprintf("%s-%d", r.config.Room, r.opened+1), Teams: r.config.Teams}
+	return r.open(settings, region, false), nil
+}
+
+func (r *Rooms) Get(name string) (*Room, bool) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	room, exists := r.byName[name]
+	return room, exists
+}
+
+// The names of the rooms which are open, in alphabetical order
+func (r *Rooms) Names() []string {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	names := make([]string, 0, len(r.byName))
+	for _, room := range r.sorted() {
+		names = append(names, room.Name)
+	}
+	return names
+}
+
+func (r *Rooms) open(settings RoomConfig, region string, permanent bool) *Room {
+	room := newRoom(settings, region, permanent, r.config, r.chat)
+	r.byName[room.Name] = room
+	r.opened++
+	log.Printf("Opened room %s in region %q with %d teams", room.Name, region, settings.Teams)
+
+	go room.run()
+	return room
+}
+
+func (r *Rooms) closeIdleRoomsLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+	for range ticker.C {
+		r.mux.Lock()
+		for name, room := range r.byName {
+			if !room.permanent && room.idleFor() > r.config.RoomIdleTimeout {
+				log.Printf("Closing room %s, nobody played in it for %s", name, r.config.RoomIdleTimeout)
+				room.cancel()
+				delete(r.byName, name)
+			}
+		}
+		r.mux.Unlock()
+	}
+}
+
+// The region of the first room and of rooms opened for players who don't mind which region they play in
+func (r *Rooms) defaultRegion() string {
+	if len(r.config.Regions) == 0 {
+		return ""
+	}
+	return r.config.Regions[0]
+}
+
+// The open rooms in alphabetical order, so the matchmaker decides the same way every time
+func (r *Rooms) sorted() []*Room {
+	rooms := make([]*Room, 0, len(r.byName))
+	for _, room := range r.byName {
+		rooms = append(rooms, room)
+	}
+	slices.SortFunc(rooms, func(a, b *Room) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return rooms
+}
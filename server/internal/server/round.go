@@ -0,0 +1,117 @@
+package server
+
+import (
+	"math"
+	"server/pkg/packets"
+	"sync"
+	"time"
+)
+
+// The lifecycle of a battle royale round: lobby -> running -> ended -> lobby -> ...
+type Round struct {
+	config  Config
+	number  uint32
+	phase   packets.RoundPhase
+	started time.Time
+	ends    time.Time
+	mux     sync.Mutex
+}
+
+func NewRound(config Config) *Round {
+	return &Round{
+		config: config,
+		phase:  packets.RoundPhase_ROUND_PHASE_NONE,
+	}
+}
+
+// Whether the game is played in rounds, if not the phase is always ROUND_PHASE_NONE
+func (r *Round) Enabled() bool {
+	return r.config.Mode == ModeRoyale
+}
+
+// The number of the current round, it increases every time a new lobby opens
+func (r *Round) Number() uint32 {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return r.number
+}
+
+func (r *Round) Phase() packets.RoundPhase {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return r.phase
+}
+
+// Whether players are currently fighting in a round
+func (r *Round) Running() bool {
+	return r.Phase() == packets.RoundPhase_ROUND_PHASE_RUNNING
+}
+
+// Time left until the current phase is over
+func (r *Round) TimeRemaining() time.Duration {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return max(time.Until(r.ends), 0)
+}
+
+// Open the lobby for a new round
+func (r *Round) OpenLobby() {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.number++
+	r.setPhase(packets.RoundPhase_ROUND_PHASE_LOBBY, r.config.LobbyDuration)
+}
+
+// Restart the lobby countdown without starting a new round, e.g. when there aren't enough players
+func (r *Round) ExtendLobby() {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.setPhase(packets.RoundPhase_ROUND_PHASE_LOBBY, r.config.LobbyDuration)
+}
+
+func (r *Round) Start() {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.setPhase(packets.RoundPhase_ROUND_PHASE_RUNNING, r.config.RoundDuration)
+}
+
+func (r *Round) End() {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.setPhase(packets.RoundPhase_ROUND_PHASE_ENDED, r.config.RoundEndDuration)
+}
+
+func (r *Round) setPhase(phase packets.RoundPhase, duration time.Duration) {
+	r.phase = phase
+	r.started = time.Now()
+	r.ends = r.started.Add(duration)
+}
+
+// The safe zone is a circle around the world origin which shrinks linearly while the round is running
+func (r *Round) Zone() (x, y, radius float64) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if r.phase != packets.RoundPhase_ROUND_PHASE_RUNNING {
+		return 0, 0, r.config.ZoneStartRadius
+	}
+
+	progress := float64(time.Since(r.started)) / float64(r.config.RoundDuration)
+	progress = min(max(progress, 0), 1)
+	radius = r.config.ZoneStartRadius + (r.config.ZoneEndRadius-r.config.ZoneStartRadius)*progress
+	return 0, 0, radius
+}
+
+// Whether a position is inside the safe zone, which is always the case outside a running round
+func (r *Round) InZone(x, y float64) bool {
+	if !r.Enabled() || !r.Running() {
+		return true
+	}
+	zoneX, zoneY, radius := r.Zone()
+	return math.Hypot(x-zoneX, y-zoneY) <= radius
+}
+
+// Mass drained per second from players outside the safe zone
+func (r *Round) DrainRate() float64 {
+	return r.config.ZoneDrainRate
+}
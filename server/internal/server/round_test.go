@@ -0,0 +1,105 @@
+package server
+
+import (
+	"server/pkg/packets"
+	"testing"
+	"time"
+)
+
+func newTestRound() *Round {
+	config := DefaultConfig()
+	config.Mode = ModeRoyale
+	return NewRound(config)
+}
+
+func TestRoundPhases(t *testing.T) {
+	round := newTestRound()
+	tests := []struct {
+		name   string
+		change func()
+		phase  packets.RoundPhase
+		number uint32
+		left   time.Duration
+	}{
+		{"new", func() {}, packets.RoundPhase_ROUND_PHASE_NONE, 0, 0},
+		{"lobby", round.OpenLobby, packets.RoundPhase_ROUND_PHASE_LOBBY, 1, round.config.LobbyDuration},
+		{"lobby extended", round.ExtendLobby, packets.RoundPhase_ROUND_PHASE_LOBBY, 1, round.config.LobbyDuration},
+		{"running", round.Start, packets.RoundPhase_ROUND_PHASE_RUNNING, 1, round.config.RoundDuration},
+		{"ended", round.End, packets.RoundPhase_ROUND_PHASE_ENDED, 1, round.config.RoundEndDuration},
+		{"next lobby", round.OpenLobby, packets.RoundPhase_ROUND_PHASE_LOBBY, 2, round.config.LobbyDuration},
+	}
+
+	for _, test := range tests {
+		test.change()
+		if phase := round.Phase(); phase != test.phase {
+			t.Errorf("%s: expected phase %s, got %s", test.name, test.phase, phase)
+		}
+		if number := round.Number(); number != test.number {
+			t.Errorf("%s: expected round %d, got %d", test.name, test.number, number)
+		}
+		if running := round.Running(); running != (test.phase == packets.RoundPhase_ROUND_PHASE_RUNNING) {
+			t.Errorf("%s: expected running to be %t", test.name, !running)
+		}
+		if left := round.TimeRemaining(); left > test.left || left < test.left-time.Second {
+			t.Errorf("%s: expected %s left, got %s", test.name, test.left, left)
+		}
+	}
+}
+
+func TestRoundZone(t *testing.T) {
+	round := newTestRound()
+	start, end := round.config.ZoneStartRadius, round.config.ZoneEndRadius
+	tests := []struct {
+		name    string
+		phase   packets.RoundPhase
+		elapsed time.Duration
+		radius  float64
+	}{
+		{"lobby", packets.RoundPhase_ROUND_PHASE_LOBBY, round.config.RoundDuration, start},
+		{"round started", packets.RoundPhase_ROUND_PHASE_RUNNING, 0, start},
+		{"halfway", packets.RoundPhase_ROUND_PHASE_RUNNING, round.config.RoundDuration / 2, (start + end) / 2},
+		{"round over", packets.RoundPhase_ROUND_PHASE_RUNNING, round.config.RoundDuration, end},
+		{"overtime", packets.RoundPhase_ROUND_PHASE_RUNNING, 2 * round.config.RoundDuration, end},
+		{"ended", packets.RoundPhase_ROUND_PHASE_ENDED, round.config.RoundDuration, start},
+	}
+
+	for _, test := range tests {
+		round.setPhase(test.phase, round.config.RoundDuration)
+		round.started = time.Now().Add(-test.elapsed)
+		if _, _, radius := round.Zone(); radius > test.radius+1 || radius < test.radius-1 {
+			t.Errorf("%s: expected a radius of %.0f, got %.0f", test.name, test.radius, radius)
+		}
+	}
+}
+
+func TestRoundInZone(t *testing.T) {
+	round := newTestRound()
+	outside := round.config.ZoneStartRadius + 1
+	if !round.InZone(outside, 0) {
+		t.Error("expected everywhere to be in the zone outside a running round")
+	}
+
+	round.Start()
+	if round.InZone(outside, 0) {
+		t.Error("expected a player beyond the radius to be outside the zone")
+	}
+	if !round.InZone(0, round.config.ZoneStartRadius-1) {
+		t.Error("expected a player within the radius to be in the zone")
+	}
+
+	endless := NewRound(DefaultConfig())
+	endless.Start()
+	if !endless.InZone(outside, 0) {
+		t.Error("expected everywhere to be in the zone when the game isn't played in rounds")
+	}
+}
+
+func TestRoundDrainRate(t *testing.T) {
+	config := DefaultConfig()
+	for _, rate := range []float64{0, 150, 1000} {
+		config.ZoneDrainRate = rate
+		if got := NewRound(config).DrainRate(); got != rate {
+			t.Errorf("expected a drain rate of %.0f, got %.0f", rate, got)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package states
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/internal/server/loginguard"
+	"server/internal/server/moderation"
+	"server/internal/server/names"
+	"server/internal/server/storage"
+	"server/pkg/packets"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	errEmptyPassword    = errors.New("the password must not be empty")
+	errPasswordTooLong  = errors.New("the password must be at most 72 bytes long")
+	errInvalidResetCode = errors.New("invalid or expired reset code")
+)
+
+func (c *Connected) handleChangePasswordRequest(senderId uint64, message *packets.Packet_ChangePasswordRequest) {
+	if senderId != c.client.Id() {
+		c.logger.Printf("Received change password request from %d, but I'm %d", senderId, c.client.Id())
+		return
+	}
+
+	request := message.ChangePasswordRequest
+	c.runJob("change password", request.Username, func(ctx context.Context) error {
+		user, err := c.checkPassword(ctx, request.Username, request.OldPassword)
+		if err != nil {
+			return err
+		}
+
+		passwordHash, err := hashPassword(request.NewPassword)
+		if err != nil {
+			return err
+		}
+		return setPassword(ctx, c.users, user, passwordHash)
+	}, func() {
+		c.logger.Printf("User %s changed their password", request.Username)
+		c.client.SocketSend(packets.NewOkResponse())
+	})
+}
+
+func (c *Connected) handleResetPasswordRequest(senderId uint64, message *packets.Packet_ResetPasswordRequest) {
+	if senderId != c.client.Id() {
+		c.logger.Printf("Received reset password request from %d, but I'm %d", senderId, c.client.Id())
+		return
+	}
+
+	request := message.ResetPasswordRequest
+	c.runJob("reset password", request.Username, func(ctx context.Context) error {
+		user, err := c.users.GetUserByUsername(ctx, names.Normalize(request.Username))
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		// Codes are checked like passwords, unknown users without any codes to compare against
+		var codeHashes []string
+		if user.ID != 0 {
+			resets, err := c.users.ListPasswordResetsByUser(ctx, user.ID)
+			if err != nil {
+				return err
+			}
+			for _, reset := range resets {
+				if time.Now().Before(reset.ExpiresAt) {
+					codeHashes = append(codeHashes, reset.CodeHash)
+				}
+			}
+		}
+
+		err = c.client.LoginGuard().CompareHashesAndSecret(request.Username, c.client.IP(), codeHashes, request.ResetCode)
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return errInvalidResetCode
+		}
+		if err != nil {
+			return err
+		}
+
+		passwordHash, err := hashPassword(request.NewPassword)
+		if err != nil {
+			return err
+		}
+
+		// Reset codes can only be used once, so they go together with the old password
+		return c.client.DbTx().WithUsersTx(func(users storage.Users) error {
+			if err := setPassword(ctx, users, user, passwordHash); err != nil {
+				return err
+			}
+			return users.DeletePasswordResetsByUser(ctx, user.ID)
+		})
+	}, func() {
+		c.logger.Printf("User %s reset their password", request.Username)
+		c.client.SocketSend(packets.NewOkResponse())
+	})
+}
+
+func (c *Connected) handleDeleteAccountRequest(senderId uint64, message *packets.Packet_DeleteAccountRequest) {
+	if senderId != c.client.Id() {
+		c.logger.Printf("Received delete account request from %d, but I'm %d", senderId, c.client.Id())
+		return
+	}
+
+	request := message.DeleteAccountRequest
+	c.runJob("delete account", request.Username, func(ctx context.Context) error {
+		user, err := c.checkPassword(ctx, request.Username, request.Password)
+		if err != nil {
+			return err
+		}
+		// The moderation records are always in the local database, the account may be in PostgreSQL
+		err = c.client.DbTx().WithTx(func(queries *db.Queries) error {
+			return unlinkModerationRecords(ctx, queries, user.ID)
+		})
+		if err != nil {
+			return err
+		}
+		return c.client.DbTx().WithUsersTx(func(users storage.Users) error {
+			return deleteAccount(ctx, users, user)
+		})
+	}, func() {
+		c.logger.Printf("User %s deleted their account", request.Username)
+		c.client.SocketSend(packets.NewOkResponse())
+	})
+}
+
+// Look up a user and check their password, an unknown user looks the same as a wrong password
+func (c *Connected) checkPassword(ctx context.Context, username string, password string) (db.User, error) {
+	user, err := c.users.GetUserByUsername(ctx, names.Normalize(username))
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return db.User{}, err
+	}
+
+	// Unknown users count as failed attempts too, with an empty hash the guard never matches
+	err = c.client.LoginGuard().CompareHashAndPassword(username, c.client.IP(), user.PasswordHash, password)
+	if err != nil {
+		return db.User{}, err
+	}
+	return user, nil
+}
+
+func hashPassword(password string) (string, error) {
+	if password == "" {
+		return "", errEmptyPassword
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if errors.Is(err, bcrypt.ErrPasswordTooLong) {
+		return "", errPasswordTooLong
+	}
+	return string(passwordHash), err
+}
+
+func setPassword(ctx context.Context, users storage.Users, user db.User, passwordHash string) error {
+	return users.UpdateUserPassword(ctx, db.UpdateUserPasswordParams{
+		PasswordHash: passwordHash,
+		ID:           user.ID,
+	})
+}
+
+// Remove a user and everything stored about them which only matters to them. Moderation records such as bans are
+// kept, they still apply to the username, but unlinkModerationRecords has to be run first so none of them point at
+// the removed user.
+func deleteAccount(ctx context.Context, users storage.Users, user db.User) error {
+	if err := users.DeletePasswordResetsByUser(ctx, user.ID); err != nil {
+		return err
+	}
+	if err := users.DeleteUserProfile(ctx, user.ID); err != nil {
+		return err
+	}
+	if err := users.DeleteSessionsByUser(ctx, sql.NullInt64{Int64: user.ID, Valid: true}); err != nil {
+		return err
+	}
+	if err := users.DeleteAchievementsByUser(ctx, user.ID); err != nil {
+		return err
+	}
+	if err := users.DeleteFriendshipsByUser(ctx, db.DeleteFriendshipsByUserParams{UserID: user.ID, FriendID: user.ID}); err != nil {
+		return err
+	}
+	if err := users.DeleteUserRating(ctx, user.ID); err != nil {
+		return err
+	}
+	if err := users.DeleteRatingHistoryByUser(ctx, user.ID); err != nil {
+		return err
+	}
+	return users.DeleteUser(ctx, user.ID)
+}
+
+// Stop the moderation records of a user from referring to them, once they are gone they are only known by name
+func unlinkModerationRecords(ctx context.Context, queries *db.Queries, userId int64) error {
+	id := sql.NullInt64{Int64: userId, Valid: true}
+	if err := queries.UnlinkBansFromUser(ctx, id); err != nil {
+		return err
+	}
+	if err := queries.UnlinkMutesFromUser(ctx, id); err != nil {
+		return err
+	}
+	if err := queries.UnlinkAuditLogFromUser(ctx, id); err != nil {
+		return err
+	}
+	if err := queries.UnlinkCheatOffensesFromUser(ctx, id); err != nil {
+		return err
+	}
+	return queries.UnlinkChatReportsFromReporter(ctx, id)
+}
+
+// Tell the client why a login, a registration or an account request failed, hiding internal errors
+func (c *Connected) denyAccountRequest(action string, username string, err error) {
+	var tooManyAttempts *loginguard.TooManyAttemptsError
+	var banned *bannedError
+	switch {
+	case errors.Is(err, bcrypt.ErrMismatchedHashAndPassword), errors.Is(err, bcrypt.ErrHashTooShort):
+		c.logger.Printf("Refusing to %s for %s: wrong username or password", action, username)
+		c.client.SocketSend(packets.NewDenyResponse("Invalid username or password"))
+	case errors.As(err, &tooManyAttempts):
+		c.logger.Printf("Refusing to %s for %s: %v", action, username, err)
+		c.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Too many failed attempts, please try again in %s", tooManyAttempts.Wait)))
+	case errors.As(err, &banned):
+		c.logger.Printf("Refusing to %s for %s: %v", action, username, err)
+		c.client.SocketSend(packets.NewDenyResponse(moderation.BanReason(banned.ban)))
+	case errors.Is(err, loginguard.ErrBusy), errors.Is(err, server.ErrWorkersBusy), errors.Is(err, context.DeadlineExceeded):
+		c.logger.Printf("Refusing to %s for %s: %v", action, username, err)
+		c.client.SocketSend(packets.NewDenyResponse("The server is busy - please try again later"))
+	case errors.Is(err, errEmptyPassword), errors.Is(err, errPasswordTooLong):
+		c.client.SocketSend(packets.NewDenyResponse("Invalid password: " + err.Error()))
+	case errors.Is(err, errInvalidResetCode):
+		c.logger.Printf("Refusing to %s for %s: %v", action, username, err)
+		c.client.SocketSend(packets.NewDenyResponse("Invalid or expired reset code"))
+	case errors.Is(err, errUserExists):
+		c.logger.Printf("Refusing to %s for %s: %v", action, username, err)
+		c.client.SocketSend(packets.NewDenyResponse("User already exists"))
+	case errors.Is(err, errNameInUse):
+		c.logger.Printf("Refusing to %s for %s: %v", action, username, err)
+		c.client.SocketSend(packets.NewDenyResponse("That name is in use right now, please pick another one"))
+	case errors.Is(err, errNameTaken):
+		c.client.SocketSend(packets.NewDenyResponse("That name is taken, please pick another one"))
+	case errors.Is(err, errAlreadyPlaying):
+		c.logger.Printf("Refusing to %s for %s: %v", action, username, err)
+		c.client.SocketSend(packets.NewDenyResponse("You are already playing on another connection"))
+	default:
+		c.logger.Printf("Failed to %s for %s: %v", action, username, err)
+		c.client.SocketSend(packets.NewDenyResponse("Error processing the request (internal server error) - please try again later"))
+	}
+}
@@ -0,0 +1,122 @@
+package states
+
+import (
+	"server/internal/server/db"
+	"server/pkg/packets"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func newConnectedClient(t *testing.T) (*testClient, *Connected) {
+	t.Helper()
+	client := newTestClient(t, newTestConfig())
+	connected := &Connected{}
+	client.enter(connected)
+	return client, connected
+}
+
+// Create a user with the given password, returns their id
+func createUser(t *testing.T, client *testClient, username string, password string) int64 {
+	t.Helper()
+	passwordHash, err := hashPassword(password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, err := client.DbTx().Users.CreateUser(client.DbTx().Ctx, db.CreateUserParams{Username: username, PasswordHash: passwordHash})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return user.ID
+}
+
+// Send the request and wait for the worker to answer it
+func request(t *testing.T, client *testClient, connected *Connected, message packets.Msg) {
+	t.Helper()
+	connected.HandleMessage(client.id, message)
+	client.runQueued(t)
+}
+
+func expectPassword(t *testing.T, client *testClient, username string, password string) {
+	t.Helper()
+	user, err := client.DbTx().Users.GetUserByUsername(client.DbTx().Ctx, username)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)) != nil {
+		t.Errorf("expected the password of %s to be %q", username, password)
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	client, connected := newConnectedClient(t)
+	createUser(t, client, "alice", "secret")
+	change := func(oldPassword, newPassword string) packets.Msg {
+		return &packets.Packet_ChangePasswordRequest{ChangePasswordRequest: &packets.ChangePasswordRequestMessage{
+			Username:    "Alice",
+			OldPassword: oldPassword,
+			NewPassword: newPassword,
+		}}
+	}
+
+	request(t, client, connected, change("wrong", "hacked"))
+	client.expectDeny(t, "Invalid username or password")
+	expectPassword(t, client, "alice", "secret")
+
+	request(t, client, connected, change("secret", ""))
+	client.expectDeny(t, "Invalid password")
+	expectPassword(t, client, "alice", "secret")
+
+	request(t, client, connected, change("secret", "better"))
+	client.expectOk(t)
+	expectPassword(t, client, "alice", "better")
+}
+
+func TestResetPassword(t *testing.T) {
+	client, connected := newConnectedClient(t)
+	userId := createUser(t, client, "alice", "secret")
+	codes := map[string]time.Duration{"expired": -time.Minute, "fresh": time.Hour}
+	for code, expiresIn := range codes {
+		codeHash, err := bcrypt.GenerateFromPassword([]byte(code), bcrypt.MinCost)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = client.DbTx().Users.CreatePasswordReset(client.DbTx().Ctx, db.CreatePasswordResetParams{
+			UserID:    userId,
+			CodeHash:  string(codeHash),
+			ExpiresAt: time.Now().Add(expiresIn).UTC(),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	reset := func(username, code string) packets.Msg {
+		return &packets.Packet_ResetPasswordRequest{ResetPasswordRequest: &packets.ResetPasswordRequestMessage{
+			Username:    username,
+			ResetCode:   code,
+			NewPassword: "new " + code,
+		}}
+	}
+
+	tests := []struct {
+		name     string
+		username string
+		code     string
+		ok       bool
+		password string
+	}{
+		{"expired code", "alice", "expired", false, "secret"},
+		{"wrong code", "alice", "guess", false, "secret"},
+		{"unknown user", "bob", "fresh", false, "secret"},
+		{"fresh code", "Alice", "fresh", true, "new fresh"},
+		{"used code", "alice", "fresh", false, "new fresh"},
+	}
+	for _, test := range tests {
+		request(t, client, connected, reset(test.username, test.code))
+		if _, ok := client.lastSent().(*packets.Packet_OkResponse); ok != test.ok {
+			t.Errorf("%s: expected the reset to succeed: %t, got %v", test.name, test.ok, client.lastSent())
+		}
+		expectPassword(t, client, "alice", test.password)
+	}
+}
@@ -0,0 +1,87 @@
+package states
+
+import (
+	"context"
+	"database/sql"
+	"server/internal/server/achievements"
+	"server/internal/server/db"
+	"server/pkg/packets"
+	"time"
+)
+
+// Look up which achievements the user already has in the background, they're checked once that's done. Guests
+// don't get any since there's nowhere to keep them.
+func (g *InGame) loadAchievements() {
+	if g.userId == 0 {
+		return
+	}
+
+	var unlocked []db.UserAchievement
+	var totals db.GetSessionTotalsByUserRow
+	users, userId := g.client.DbTx().Users, g.userId
+	err := g.client.Workers().Submit(g.client, func(ctx context.Context) error {
+		var err error
+		unlocked, err = users.ListAchievementsByUser(ctx, userId)
+		if err != nil {
+			return err
+		}
+		totals, err = users.GetSessionTotalsByUser(ctx, sql.NullInt64{Int64: userId, Valid: true})
+		return err
+	}, func(err error) {
+		if err != nil {
+			g.logger.Printf("Failed to load the achievements of user %d: %v", userId, err)
+			return
+		}
+
+		ids := make([]string, len(unlocked))
+		for i, achievement := range unlocked {
+			ids[i] = achievement.AchievementID
+		}
+		g.achievements.Store(achievements.NewTracker(g.client.Config().Achievements, ids, achievements.Totals{
+			SporesEaten:  totals.SporesEaten,
+			PlayersEaten: totals.PlayersEaten,
+		}))
+		g.checkAchievements()
+	})
+	if err != nil {
+		g.logger.Printf("Failed to load the achievements of user %d: %v", userId, err)
+	}
+}
+
+// Unlock the achievements the player reached, telling them and storing them in the background. Must be called from the
+// client's own goroutine.
+func (g *InGame) checkAchievements() {
+	tracker := g.achievements.Load()
+	if tracker == nil {
+		return
+	}
+
+	unlocked := tracker.Check(achievements.Stats{
+		Mass:         radToMass(g.player.Radius),
+		TimeAlive:    time.Since(g.spawnedAt),
+		SporesEaten:  g.sporesEaten,
+		PlayersEaten: g.playersEaten,
+		LargestKill:  g.largestKill,
+	})
+
+	users, logger := g.client.DbTx().Users, g.logger
+	for _, achievement := range unlocked {
+		g.logger.Printf("Player unlocked the achievement %s", achievement.Id)
+		g.client.SocketSend(packets.NewAchievementUnlocked(achievement.Id, achievement.Name, achievement.Description))
+
+		params := db.UnlockAchievementParams{
+			UserID:        g.userId,
+			AchievementID: achievement.Id,
+			UnlockedAt:    time.Now().UTC(),
+		}
+		err := g.client.Workers().Submit(g.client, func(ctx context.Context) error {
+			if err := users.UnlockAchievement(ctx, params); err != nil {
+				logger.Printf("Failed to store the achievement %s of user %d: %v", params.AchievementID, params.UserID, err)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			g.logger.Printf("Failed to store the achievement %s of user %d: %v", params.AchievementID, params.UserID, err)
+		}
+	}
+}
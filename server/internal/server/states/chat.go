@@ -0,0 +1,165 @@
+package states
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/chatfilter"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"strings"
+	"time"
+)
+
+// Route a chat message our own player sent to the players it's meant for
+func sendChat(client server.ClientInterfacer, player *objects.Player, message *packets.Packet_Chat, logger *log.Logger) {
+	if isMuted(client) {
+		return
+	}
+
+	text, err := client.ChatFilter().Run(message.Chat.Msg)
+	if err != nil {
+		client.SocketSend(packets.NewDenyResponse("Message not sent: " + err.Error()))
+		return
+	}
+	message.Chat.Msg = text
+
+	// Only the server gets to send system messages
+	message.Chat.System = false
+
+	switch message.Chat.Target {
+	case packets.ChatTarget_CHAT_TARGET_TEAM:
+		// Players who are dead or spectating still belong to the team they last played on
+		if client.SharedGameObjects().Teams.Last(player.Name) == 0 {
+			client.SocketSend(packets.NewDenyResponse("You are not on a team"))
+			return
+		}
+		client.Broadcast(message)
+
+	case packets.ChatTarget_CHAT_TARGET_DIRECT:
+		// Whispers reach players whatever they're doing and wherever they are, as long as they're logged in
+		targetId := message.Chat.TargetId
+		peer, online := client.Peer(targetId)
+		if online {
+			_, online = peer.State().(server.UserStateHandler)
+		}
+		if !online || targetId == client.Id() {
+			client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Player %d is not online", targetId)))
+			return
+		}
+		client.PassToPeer(message, targetId)
+
+	case packets.ChatTarget_CHAT_TARGET_ROOM:
+		client.Broadcast(message)
+
+	default:
+		// Global chat reaches every room, and it's what players catch up on when they join
+		client.BroadcastAll(message)
+		storeChat(client, client.SharedGameObjects().Chat.Add(client.Id(), player.Name, text), logger)
+	}
+
+	client.ChatHistory().Record(client.Id(), player.Name, text)
+}
+
+// Pass on a chat message from another player if it's meant for our own player
+func receiveChat(client server.ClientInterfacer, player *objects.Player, senderId uint64, message *packets.Packet_Chat) {
+	senderName, named := client.Names().Name(senderId)
+	if message.Chat.Target == packets.ChatTarget_CHAT_TARGET_TEAM {
+		// Look the teams up by name, the players are only in the game while they're alive
+		teams := client.SharedGameObjects().Teams
+		team := teams.Last(player.Name)
+		if !named || team == 0 || teams.Last(senderName) != team {
+			return
+		}
+	}
+
+	// Remember what other players said in case our player reports it, also when the sender is dead, spectating or in
+	// another room. There's no point in reporting the server.
+	if named && !message.Chat.System {
+		client.ChatHistory().Record(senderId, senderName, message.Chat.Msg)
+	}
+
+	client.SocketSendAs(message, senderId)
+}
+
+// Send the recent public chat messages to a player who just joined
+func sendChatHistory(client server.ClientInterfacer) {
+	entries := client.SharedGameObjects().Chat.Recent()
+	messages := make([]*packets.ChatHistoryEntryMessage, len(entries))
+	for i, entry := range entries {
+		messages[i] = &packets.ChatHistoryEntryMessage{
+			SenderId:   entry.SenderId,
+			SenderName: entry.SenderName,
+			Msg:        entry.Msg,
+			SentAt:     entry.SentAt.UnixMilli(),
+		}
+	}
+	client.SocketSend(packets.NewChatHistory(messages))
+}
+
+// Store a public chat message so the history survives restarts. It's written by a worker so chatting doesn't wait for
+// the database.
+func storeChat(client server.ClientInterfacer, entry server.ChatLogEntry, logger *log.Logger) {
+	chatLog := client.SharedGameObjects().Chat
+	err := client.Workers().Submit(client, func(ctx context.Context) error {
+		if err := chatLog.Store(ctx, entry); err != nil {
+			logger.Printf("Failed to store chat message from %s: %v", entry.SenderName, err)
+		}
+		return nil
+	}, nil)
+	if err != nil {
+		logger.Printf("Failed to store chat message from %s: %v", entry.SenderName, err)
+	}
+}
+
+// Check whether a player may chat, telling them for how long they can't if not
+func isMuted(client server.ClientInterfacer) bool {
+	remaining := client.MuteStatus().Remaining()
+	if remaining <= 0 {
+		return false
+	}
+
+	client.SocketSendAs(packets.NewSystemChat(fmt.Sprintf("You are muted for another %s", remaining.Truncate(time.Second))), 0)
+	return true
+}
+
+// Store a chat message our own player reported, together with the conversation leading up to it, for moderators to look at
+func reportMessage(client server.ClientInterfacer, player *objects.Player, userId int64, message *packets.Packet_ReportMessage, logger *log.Logger) {
+	report := message.ReportMessage
+	if report.PlayerId == client.Id() {
+		client.SocketSend(packets.NewDenyResponse("You can't report your own messages"))
+		return
+	}
+
+	reported, conversation, found := client.ChatHistory().Find(report.PlayerId, report.Msg)
+	if !found {
+		client.SocketSend(packets.NewDenyResponse("That message can't be reported, only recent messages can"))
+		return
+	}
+
+	reason := strings.TrimSpace(report.Reason)
+	if reason == "" {
+		reason = "No reason given"
+	}
+
+	params := db.CreateChatReportParams{
+		ReporterID:   sql.NullInt64{Int64: userId, Valid: userId != 0},
+		ReporterName: player.Name,
+		ReportedName: reported.SenderName,
+		Message:      reported.Text,
+		Reason:       reason,
+		Context:      chatfilter.FormatContext(conversation),
+	}
+
+	queries := client.DbTx().Queries
+	runUserJob(client, "send the report", logger, func(ctx context.Context) error {
+		_, err := queries.CreateChatReport(ctx, params)
+		return err
+	}, func() {
+		logger.Printf("%s reported a message from %s: %s", params.ReporterName, params.ReportedName, reason)
+		client.SocketSendAs(packets.NewSystemChat(fmt.Sprintf("Thanks, a moderator will look at the message from %s", params.ReportedName)), 0)
+	})
+}
@@ -0,0 +1,197 @@
+package states
+
+import (
+	"io"
+	"log"
+	"server/internal/server"
+	"server/internal/server/anticheat"
+	"server/internal/server/chatfilter"
+	"server/internal/server/loginguard"
+	"server/internal/server/moderation"
+	"server/internal/server/names"
+	"server/pkg/packets"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// A client of an in-memory hub which records what's sent to it instead of writing to a socket. The states it's moved
+// to aren't entered, so a test only sees which state it moved to without starting a game.
+type testClient struct {
+	server.ClientInterfacer
+	id        uint64
+	hub       *server.Hub
+	dbTx      *server.DbTx
+	room      *server.Room
+	state     server.ClientStateHandler
+	antiCheat *anticheat.Tracker
+	chat      *chatfilter.History
+	mute      moderation.MuteStatus
+	rating    float64
+	queued    chan server.LocalMsg
+
+	mux       sync.Mutex
+	sent      []packets.Msg
+	broadcast []packets.Msg
+	kicked    string
+}
+
+func newTestConfig() server.Config {
+	config := server.DefaultConfig()
+	config.DbPath = server.InMemoryDb
+	return config
+}
+
+func newTestClient(t *testing.T, config server.Config) *testClient {
+	t.Helper()
+	hub := server.NewHub(config)
+	hub.Rooms.Start()
+	room, _ := hub.Rooms.Get(config.Room)
+	return newTestClientOf(hub, room, 1)
+}
+
+// Another client of the same hub, e.g. a moderator's target
+func newTestClientOf(hub *server.Hub, room *server.Room, id uint64) *testClient {
+	return &testClient{
+		id:        id,
+		hub:       hub,
+		dbTx:      hub.NewDbTx(),
+		room:      room,
+		antiCheat: anticheat.NewTracker(log.New(io.Discard, "", 0), nil),
+		chat:      chatfilter.NewHistory(10),
+		queued:    make(chan server.LocalMsg, 64),
+	}
+}
+
+// Put the client in a state as if it had been moved there
+func (c *testClient) enter(state server.ClientStateHandler) {
+	c.state = state
+	state.SetClient(c)
+	state.OnEnter()
+}
+
+// Run the next task queued to the client, if it's still in the state which queued it
+func (c *testClient) runQueued(t *testing.T) {
+	t.Helper()
+	select {
+	case message := <-c.queued:
+		switch message := message.(type) {
+		case *server.StateTask:
+			if message.State == c.state {
+				message.Run()
+			}
+		default:
+			if handler, ok := c.state.(server.LocalMsgHandler); ok {
+				handler.HandleLocal(message)
+			}
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a task to be queued to the client")
+	}
+}
+
+func (c *testClient) clearSent() {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.sent = nil
+}
+
+func (c *testClient) lastSent() packets.Msg {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if len(c.sent) == 0 {
+		return nil
+	}
+	return c.sent[len(c.sent)-1]
+}
+
+// Fail unless the last packet sent to the client was an OK response
+func (c *testClient) expectOk(t *testing.T) {
+	t.Helper()
+	if _, ok := c.lastSent().(*packets.Packet_OkResponse); !ok {
+		t.Errorf("expected an OK response, got %v", c.lastSent())
+	}
+}
+
+// Fail unless the last packet sent to the client was a deny response containing reason
+func (c *testClient) expectDeny(t *testing.T, reason string) {
+	t.Helper()
+	deny, ok := c.lastSent().(*packets.Packet_DenyResponse)
+	if !ok || !strings.Contains(deny.DenyResponse.Reason, reason) {
+		t.Errorf("expected to be denied with %q, got %v", reason, c.lastSent())
+	}
+}
+
+// Fail unless the last packet sent to the client was a system chat message containing text
+func (c *testClient) expectSystemMessage(t *testing.T, text string) {
+	t.Helper()
+	chat, ok := c.lastSent().(*packets.Packet_Chat)
+	if !ok || !chat.Chat.System || !strings.Contains(chat.Chat.Msg, text) {
+		t.Errorf("expected a system message with %q, got %v", text, c.lastSent())
+	}
+}
+
+func (c *testClient) Id() uint64 {
+	return c.id
+}
+
+func (c *testClient) SetState(state server.ClientStateHandler) {
+	c.state = state
+}
+
+func (c *testClient) JoinRoom(room *server.Room, state server.ClientStateHandler) {
+	c.room = room
+	c.state = state
+}
+
+func (c *testClient) State() server.ClientStateHandler {
+	return c.state
+}
+
+func (c *testClient) Queue(message server.LocalMsg) {
+	c.queued <- message
+}
+
+func (c *testClient) SocketSend(message packets.Msg) {
+	c.SocketSendAs(message, c.id)
+}
+
+func (c *testClient) SocketSendAs(message packets.Msg, senderId uint64) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.sent = append(c.sent, message)
+}
+
+func (c *testClient) Broadcast(message packets.Msg) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.broadcast = append(c.broadcast, message)
+}
+
+func (c *testClient) Kick(reason string) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.kicked = reason
+}
+
+func (c *testClient) SharedGameObjects() *server.SharedGameObjects { return c.room.SharedGameObjects }
+func (c *testClient) Room() *server.Room                           { return c.room }
+func (c *testClient) Rating() float64                              { return c.rating }
+func (c *testClient) SetRating(rating float64)                     { c.rating = rating }
+func (c *testClient) DbTx() *server.DbTx                           { return c.dbTx }
+func (c *testClient) Config() server.Config                        { return c.hub.Config }
+func (c *testClient) AntiCheat() *anticheat.Tracker                { return c.antiCheat }
+func (c *testClient) IP() string                                   { return "10.0.0.1" }
+func (c *testClient) ChatHistory() *chatfilter.History             { return c.chat }
+func (c *testClient) MuteStatus() *moderation.MuteStatus           { return &c.mute }
+func (c *testClient) LoginGuard() *loginguard.Guard                { return c.hub.LoginGuard }
+func (c *testClient) Names() *names.Registry                       { return c.hub.Names }
+func (c *testClient) Workers() *server.WorkerPool                  { return c.hub.Workers }
+func (c *testClient) Presence() *server.Presence                   { return c.hub.Presence }
+func (c *testClient) Parties() *server.Parties                     { return c.hub.Parties }
+func (c *testClient) Rooms() *server.Rooms                         { return c.hub.Rooms }
+
+func (c *testClient) Peer(peerId uint64) (server.ClientInterfacer, bool) {
+	return c.hub.Clients.Get(peerId)
+}
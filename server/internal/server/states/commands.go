@@ -0,0 +1,579 @@
+package states
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"math"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/internal/server/moderation"
+	"server/internal/server/names"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A slash command players can type into the chat
+type chatCommand struct {
+	usage       string
+	description string
+	// The least privileged role allowed to use the command, uses of privileged commands are audit-logged
+	role moderation.Role
+	run  func(c *commander, args []string) (string, error)
+}
+
+var chatCommands map[string]chatCommand
+
+// A player running a chat command, whether they're playing, dead or spectating
+type commander struct {
+	client server.ClientInterfacer
+	player *objects.Player
+	userId int64
+	logger *log.Logger
+	// The player's state if they're playing, nil otherwise
+	game *InGame
+	// The command being run, as the player typed it
+	text    string
+	command chatCommand
+	// The player's role, looked up before the command runs
+	role moderation.Role
+}
+
+var (
+	errNotPlaying = errors.New("you have to be playing to do that")
+	// Returned by commands which finish once a worker ran their database queries, they reply then
+	errPending = errors.New("the command is waiting for a worker")
+)
+
+func init() {
+	chatCommands = map[string]chatCommand{
+		"help":     {"/help", "List the commands you can use", moderation.RolePlayer, (*commander).helpCommand},
+		"who":      {"/who", "List the players in the game", moderation.RolePlayer, (*commander).whoCommand},
+		"stats":    {"/stats", "Show how you're doing", moderation.RolePlayer, (*commander).statsCommand},
+		"kick":     {"/kick <player> [reason]", "Disconnect a player", moderation.RoleModerator, (*commander).kickCommand},
+		"mute":     {"/mute <player> <duration> [reason]", "Stop a player from chatting, 0s unmutes", moderation.RoleModerator, (*commander).muteCommand},
+		"ban":      {"/ban <player> <duration|forever> [reason]", "Ban a player and disconnect them", moderation.RoleModerator, (*commander).banCommand},
+		"tp":       {"/tp <x> <y> | /tp <player>", "Teleport yourself", moderation.RoleAdmin, (*commander).teleportCommand},
+		"setmass":  {"/setmass <mass> [player]", "Set the mass of yourself or another player", moderation.RoleAdmin, (*commander).setMassCommand},
+		"spores":   {"/spores <n>", "Spawn n spores", moderation.RoleAdmin, (*commander).sporesCommand},
+		"announce": {"/announce <message>", "Send a message to everyone from the server", moderation.RoleAdmin, (*commander).announceCommand},
+		"logins":   {"/logins", "Show failed login statistics", moderation.RoleAdmin, (*commander).loginsCommand},
+	}
+}
+
+var errUsage = errors.New("wrong arguments")
+
+// Run a chat message starting with a slash as a command, replying to the player privately
+func (c *commander) handleCommand(text string) {
+	fields := strings.Fields(strings.TrimPrefix(text, "/"))
+	if len(fields) == 0 {
+		return
+	}
+
+	name := strings.ToLower(fields[0])
+	command, exists := chatCommands[name]
+	if !exists {
+		c.sendSystemMessage(fmt.Sprintf("Unknown command /%s, type /help for a list of commands", name))
+		return
+	}
+
+	c.text, c.command = text, command
+
+	// Guests are always players
+	if c.userId == 0 {
+		c.run(name, moderation.RolePlayer, fields[1:])
+		return
+	}
+
+	var role moderation.Role
+	c.submit(func(ctx context.Context) error {
+		role = c.lookupRole(ctx)
+		return nil
+	}, func() {
+		c.run(name, role, fields[1:])
+	})
+}
+
+// Run the command if the player's role allows it
+func (c *commander) run(name string, role moderation.Role, args []string) {
+	c.role = role
+	if !role.AtLeast(c.command.role) {
+		c.logger.Printf("Player with role %s tried to use /%s", role, name)
+		c.sendSystemMessage(fmt.Sprintf("You are not allowed to use /%s", name))
+		return
+	}
+
+	result, err := c.command.run(c, args)
+	if errors.Is(err, errPending) {
+		return
+	}
+	c.finish(result, err)
+}
+
+// Reply to the player with the result of the command
+func (c *commander) finish(result string, err error) {
+	switch {
+	case errors.Is(err, errUsage):
+		result = "Usage: " + c.command.usage
+	case errors.Is(err, server.ErrWorkersBusy), errors.Is(err, context.DeadlineExceeded):
+		c.logger.Printf("Refusing to run %q: %v", c.text, err)
+		result = "Error: the server is busy - please try again later"
+	case err != nil:
+		result = "Error: " + err.Error()
+	}
+
+	if c.command.role != moderation.RolePlayer {
+		c.audit(c.text, result)
+	}
+	c.sendSystemMessage(result)
+}
+
+// Run the database queries of the command on a worker, then carry on with done on the client's goroutine if the
+// player is still in the same state. The command fails if the queries do.
+func (c *commander) submit(work func(ctx context.Context) error, done func()) {
+	err := c.client.Workers().Submit(c.client, work, func(err error) {
+		if err != nil {
+			c.finish("", err)
+			return
+		}
+		done()
+	})
+	if err != nil {
+		c.finish("", err)
+	}
+}
+
+// Send a chat message only this player sees, from the server
+func (c *commander) sendSystemMessage(text string) {
+	c.client.SocketSendAs(packets.NewSystemChat(text), 0)
+}
+
+// Look up the role of the registered player on a worker, they're a player if that fails
+func (c *commander) lookupRole(ctx context.Context) moderation.Role {
+	user, err := c.client.DbTx().Users.GetUserByID(ctx, c.userId)
+	if err != nil {
+		c.logger.Printf("Error getting user %d: %v", c.userId, err)
+		return moderation.RolePlayer
+	}
+	return moderation.Role(user.Role)
+}
+
+// Fail if the target has a higher role than the player, so moderators can't kick, mute or ban admins. Guests never
+// outrank anyone. Runs on a worker.
+func (c *commander) checkOutranks(ctx context.Context, target target) error {
+	if target.userId == 0 {
+		return nil
+	}
+
+	user, err := c.client.DbTx().Users.GetUserByID(ctx, target.userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if role := moderation.Role(user.Role); !c.role.AtLeast(role) {
+		return fmt.Errorf("%s has the %s role, you can't do that to them", target.name, role)
+	}
+	return nil
+}
+
+// Keep a record of a privileged command, written by a worker
+func (c *commander) audit(command string, result string) {
+	params := db.CreateAuditLogEntryParams{
+		UserID:    sql.NullInt64{Int64: c.userId, Valid: c.userId != 0},
+		ActorName: c.player.Name,
+		Command:   command,
+		Result:    result,
+	}
+
+	queries, logger := c.client.DbTx().Queries, c.logger
+	err := c.client.Workers().Submit(c.client, func(ctx context.Context) error {
+		if err := queries.CreateAuditLogEntry(ctx, params); err != nil {
+			logger.Printf("Failed to write audit log entry for %q: %v", command, err)
+		}
+		return nil
+	}, nil)
+	if err != nil {
+		c.logger.Printf("Failed to write audit log entry for %q: %v", command, err)
+	}
+}
+
+// A player moderators act on, whatever they're doing and whichever room they're in
+type target struct {
+	// nil if the player isn't online
+	client server.ClientInterfacer
+	name   string
+	// 0 for guests
+	userId int64
+}
+
+// Find a logged-in client by id or by a name they hold, in any room
+func (c *commander) findClient(idOrName string) (target, error) {
+	clientId, found := c.client.Names().Owner(idOrName)
+	if id, err := strconv.ParseUint(idOrName, 10, 64); err == nil {
+		if _, exists := c.client.Peer(id); exists {
+			clientId, found = id, true
+		}
+	}
+
+	if found {
+		if peer, exists := c.client.Peer(clientId); exists {
+			if state, loggedIn := peer.State().(server.UserStateHandler); loggedIn {
+				name, _ := c.client.Names().Name(clientId)
+				return target{client: peer, name: name, userId: state.UserId()}, nil
+			}
+		}
+	}
+	return target{}, fmt.Errorf("no player called %s is online", idOrName)
+}
+
+// Find a player to mute or ban, who doesn't need to be online. Players who aren't are known by name, and by their
+// account if the name is a username. Runs on a worker.
+func (c *commander) findTarget(ctx context.Context, idOrName string) (target, error) {
+	if target, err := c.findClient(idOrName); err == nil {
+		return target, nil
+	}
+
+	offline := target{name: idOrName}
+	user, err := c.client.DbTx().Users.GetUserByUsername(ctx, names.Normalize(idOrName))
+	if err == nil {
+		offline.userId = user.ID
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return target{}, err
+	}
+	return offline, nil
+}
+
+// Find a player alive in the client's room by id or by name
+func (c *commander) findPlayer(idOrName string) (uint64, *objects.Player, error) {
+	players := c.client.SharedGameObjects().Players
+
+	if id, err := strconv.ParseUint(idOrName, 10, 64); err == nil {
+		if player, exists := players.Get(id); exists {
+			return id, player, nil
+		}
+	}
+
+	var foundId uint64
+	var found *objects.Player
+	players.ForEach(func(playerId uint64, player *objects.Player) {
+		if strings.EqualFold(player.Name, idOrName) {
+			foundId, found = playerId, player
+		}
+	})
+
+	if found == nil {
+		return 0, nil, fmt.Errorf("no player called %s", idOrName)
+	}
+	return foundId, found, nil
+}
+
+func (c *commander) helpCommand(_ []string) (string, error) {
+	lines := []string{"Commands:"}
+	for _, name := range slices.Sorted(maps.Keys(chatCommands)) {
+		if command := chatCommands[name]; c.role.AtLeast(command.role) {
+			lines = append(lines, fmt.Sprintf("%s - %s", command.usage, command.description))
+		}
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+func (c *commander) whoCommand(_ []string) (string, error) {
+	type entry struct {
+		id   uint64
+		name string
+	}
+
+	var entries []entry
+	c.client.SharedGameObjects().Players.ForEach(func(playerId uint64, player *objects.Player) {
+		entries = append(entries, entry{playerId, player.Name})
+	})
+	sort.Slice(entries, func(i, j int) bool { return entries[i].id < entries[j].id })
+
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = fmt.Sprintf("%s (%d)", entry.name, entry.id)
+	}
+	return fmt.Sprintf("%d players in %s: %s", len(entries), c.client.Room().Name, strings.Join(names, ", ")), nil
+}
+
+func (c *commander) statsCommand(_ []string) (string, error) {
+	if c.game == nil {
+		return "", errNotPlaying
+	}
+
+	c.game.updateRank()
+	return fmt.Sprintf("Mass: %.0f, best rank: %d, alive for %s",
+		radToMass(c.player.Radius),
+		c.game.bestRank,
+		time.Since(c.game.spawnedAt).Truncate(time.Second),
+	), nil
+}
+
+func (c *commander) kickCommand(args []string) (string, error) {
+	if len(args) < 1 {
+		return "", errUsage
+	}
+
+	target, err := c.findClient(args[0])
+	if err != nil {
+		return "", err
+	}
+
+	reason := "Kicked by a moderator"
+	if len(args) > 1 {
+		reason += ": " + strings.Join(args[1:], " ")
+	}
+
+	c.submit(func(ctx context.Context) error {
+		return c.checkOutranks(ctx, target)
+	}, func() {
+		target.client.Kick(reason)
+		c.finish(fmt.Sprintf("Kicked %s", target.name), nil)
+	})
+	return "", errPending
+}
+
+func (c *commander) muteCommand(args []string) (string, error) {
+	if len(args) < 2 {
+		return "", errUsage
+	}
+
+	duration, err := time.ParseDuration(args[1])
+	if err != nil || duration < 0 {
+		return "", errUsage
+	}
+
+	reason := "Muted by a moderator"
+	if len(args) > 2 {
+		reason = strings.Join(args[2:], " ")
+	}
+
+	// The player doesn't need to be online to be muted
+	var target target
+	var mute db.Mute
+	c.submit(func(ctx context.Context) error {
+		var err error
+		if target, err = c.findTarget(ctx, args[0]); err != nil {
+			return err
+		}
+		if err := c.checkOutranks(ctx, target); err != nil {
+			return err
+		}
+
+		if duration == 0 {
+			return moderation.Unmute(ctx, c.client.DbTx().Queries, target.userId, target.name)
+		}
+
+		// Guests are muted by their address too, if they're online
+		params := moderation.MuteParams{
+			UserId:   target.userId,
+			Username: target.name,
+			Reason:   reason,
+			IssuedBy: c.player.Name,
+			Duration: duration,
+		}
+		if target.client != nil {
+			params.IP = target.client.IP()
+		}
+
+		return c.client.DbTx().WithTx(func(queries *db.Queries) error {
+			mute, err = moderation.Mute(ctx, queries, params)
+			return err
+		})
+	}, func() {
+		// Players who are online find out whether they're muted without asking the database
+		if target.client != nil {
+			target.client.MuteStatus().Set(mute.ExpiresAt)
+		}
+
+		if duration == 0 {
+			c.finish(fmt.Sprintf("Unmuted %s", target.name), nil)
+			return
+		}
+		c.finish(fmt.Sprintf("Muted %s for %s", target.name, duration), nil)
+	})
+	return "", errPending
+}
+
+func (c *commander) banCommand(args []string) (string, error) {
+	if len(args) < 2 {
+		return "", errUsage
+	}
+
+	var duration time.Duration
+	if args[1] != "forever" {
+		var err error
+		duration, err = time.ParseDuration(args[1])
+		if err != nil || duration <= 0 {
+			return "", errUsage
+		}
+	}
+
+	reason := "Banned by a moderator"
+	if len(args) > 2 {
+		reason = strings.Join(args[2:], " ")
+	}
+
+	// The player doesn't need to be online to be banned
+	var target target
+	var ban db.Ban
+	c.submit(func(ctx context.Context) error {
+		var err error
+		if target, err = c.findTarget(ctx, args[0]); err != nil {
+			return err
+		}
+		if err := c.checkOutranks(ctx, target); err != nil {
+			return err
+		}
+
+		params := moderation.BanParams{
+			UserId:   target.userId,
+			Username: target.name,
+			Reason:   reason,
+			IssuedBy: c.player.Name,
+			Duration: duration,
+		}
+		return c.client.DbTx().WithTx(func(queries *db.Queries) error {
+			ban, err = moderation.Ban(ctx, queries, params)
+			return err
+		})
+	}, func() {
+		if target.client != nil {
+			target.client.Kick(moderation.BanReason(&ban))
+		}
+		c.finish(fmt.Sprintf("Banned %s (ban %d)", target.name, ban.ID), nil)
+	})
+	return "", errPending
+}
+
+func (c *commander) teleportCommand(args []string) (string, error) {
+	if c.game == nil {
+		return "", errNotPlaying
+	}
+
+	var x, y float64
+	switch len(args) {
+	case 1:
+		_, player, err := c.findPlayer(args[0])
+		if err != nil {
+			return "", err
+		}
+		x, y = player.X, player.Y
+	case 2:
+		var errX, errY error
+		x, errX = strconv.ParseFloat(args[0], 64)
+		y, errY = strconv.ParseFloat(args[1], 64)
+		if errX != nil || errY != nil || math.IsNaN(x) || math.IsNaN(y) || math.IsInf(x, 0) || math.IsInf(y, 0) {
+			return "", errUsage
+		}
+	default:
+		return "", errUsage
+	}
+
+	c.player.X, c.player.Y = x, y
+	c.syncPlayerNow(c.client.Id(), c.player)
+	return fmt.Sprintf("Teleported to %.0f, %.0f", x, y), nil
+}
+
+func (c *commander) setMassCommand(args []string) (string, error) {
+	if len(args) < 1 || len(args) > 2 {
+		return "", errUsage
+	}
+
+	mass, err := strconv.ParseFloat(args[0], 64)
+	if err != nil || !(mass >= radToMass(minRadius)) || math.IsInf(mass, 0) {
+		return "", fmt.Errorf("mass must be a number of at least %.0f", radToMass(minRadius))
+	}
+
+	if len(args) == 1 {
+		if c.game == nil {
+			return "", errNotPlaying
+		}
+		c.game.setMass(mass)
+		return fmt.Sprintf("Set the mass of %s to %.0f", c.player.Name, mass), nil
+	}
+
+	playerId, player, err := c.findPlayer(args[1])
+	if err != nil {
+		return "", err
+	}
+
+	// Only the other player's own goroutine may change their player
+	peer, exists := c.client.Peer(playerId)
+	if !exists {
+		return "", fmt.Errorf("no player called %s", args[1])
+	}
+	game, playing := peer.State().(*InGame)
+	if !playing {
+		return "", fmt.Errorf("%s is not playing", player.Name)
+	}
+	server.QueueTask(peer, game, func() { game.setMass(mass) })
+	return fmt.Sprintf("Set the mass of %s to %.0f", player.Name, mass), nil
+}
+
+func (c *commander) sporesCommand(args []string) (string, error) {
+	const maxSpores = 500
+	if len(args) != 1 {
+		return "", errUsage
+	}
+
+	count, err := strconv.Atoi(args[0])
+	if err != nil || count < 1 || count > maxSpores {
+		return "", fmt.Errorf("the number of spores must be between 1 and %d", maxSpores)
+	}
+
+	gameObjects := c.client.SharedGameObjects()
+	for range count {
+		spore := gameObjects.NewSpore()
+		sporeId := gameObjects.Spores.Add(spore)
+		sporePacket := packets.NewSpore(sporeId, spore)
+		c.client.Broadcast(sporePacket)
+		c.client.SocketSend(sporePacket)
+	}
+	return fmt.Sprintf("Spawned %d spores", count), nil
+}
+
+func (c *commander) announceCommand(args []string) (string, error) {
+	if len(args) == 0 {
+		return "", errUsage
+	}
+
+	c.client.SystemBroadcast(packets.NewSystemChat(strings.Join(args, " ")))
+	return "Announcement sent", nil
+}
+
+func (c *commander) loginsCommand(_ []string) (string, error) {
+	stats := c.client.LoginGuard().Stats()
+	return fmt.Sprintf("Failed logins: %d, throttled: %d, lockouts: %d, refused while busy: %d",
+		stats.Failures,
+		stats.Throttled,
+		stats.Lockouts,
+		stats.Busy,
+	), nil
+}
+
+// Let everyone know about a change to a player right away instead of waiting for the next update
+func (c *commander) syncPlayerNow(playerId uint64, player *objects.Player) {
+	updatePacket := packets.NewPlayer(playerId, player)
+	c.client.Broadcast(updatePacket)
+	c.client.SocketSend(updatePacket)
+}
+
+// Change the mass of the player at an admin's request, letting everyone know right away
+func (g *InGame) setMass(mass float64) {
+	g.player.Radius = massToRad(mass)
+	updatePacket := packets.NewPlayer(g.client.Id(), g.player)
+	g.client.Broadcast(updatePacket)
+	g.client.SocketSend(updatePacket)
+}
@@ -0,0 +1,89 @@
+package states
+
+import (
+	"io"
+	"log"
+	"server/internal/server/db"
+	"server/internal/server/moderation"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"strings"
+	"testing"
+)
+
+// Create a user with the given role, returns their id
+func createUserWithRole(t *testing.T, client *testClient, username string, role moderation.Role) int64 {
+	t.Helper()
+	users, ctx := client.DbTx().Users, client.DbTx().Ctx
+	user, err := users.CreateUser(ctx, db.CreateUserParams{Username: username, PasswordHash: "hash"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := users.SetUserRole(ctx, db.SetUserRoleParams{Role: string(role), ID: user.ID}); err != nil {
+		t.Fatal(err)
+	}
+	return user.ID
+}
+
+func TestCommandsNeedTheirRole(t *testing.T) {
+	client := newTestClient(t, newTestConfig())
+	userIds := map[moderation.Role]int64{
+		moderation.RolePlayer:    createUserWithRole(t, client, "player", moderation.RolePlayer),
+		moderation.RoleModerator: createUserWithRole(t, client, "moderator", moderation.RoleModerator),
+		moderation.RoleAdmin:     createUserWithRole(t, client, "admin", moderation.RoleAdmin),
+	}
+
+	tests := []struct {
+		name  string
+		guest bool
+		role  moderation.Role
+		text  string
+		reply string
+	}{
+		{"guest kicking", true, "", "/kick", "You are not allowed to use /kick"},
+		{"player kicking", false, moderation.RolePlayer, "/kick", "You are not allowed to use /kick"},
+		{"player teleporting", false, moderation.RolePlayer, "/tp 0 0", "You are not allowed to use /tp"},
+		{"moderator teleporting", false, moderation.RoleModerator, "/tp 0 0", "You are not allowed to use /tp"},
+		{"moderator spawning spores", false, moderation.RoleModerator, "/SPORES 5", "You are not allowed to use /spores"},
+		{"moderator kicking", false, moderation.RoleModerator, "/kick", "Usage: /kick"},
+		{"admin spawning spores", false, moderation.RoleAdmin, "/spores", "Usage: /spores"},
+		{"player asking for help", false, moderation.RolePlayer, "/help", "/stats"},
+	}
+
+	for _, test := range tests {
+		client.clearSent()
+		c := &commander{client: client, player: &objects.Player{Name: "bob"}, logger: log.New(io.Discard, "", 0)}
+		if !test.guest {
+			c.userId = userIds[test.role]
+		}
+
+		c.handleCommand(test.text)
+		// Registered players' roles are looked up by a worker first
+		if !test.guest {
+			client.runQueued(t)
+		}
+		client.expectSystemMessage(t, test.reply)
+	}
+}
+
+func TestHelpListsAllowedCommands(t *testing.T) {
+	client := newTestClient(t, newTestConfig())
+	for _, role := range []moderation.Role{moderation.RolePlayer, moderation.RoleModerator, moderation.RoleAdmin} {
+		client.clearSent()
+		c := &commander{client: client, player: &objects.Player{Name: "bob"}, logger: log.New(io.Discard, "", 0)}
+		c.userId = createUserWithRole(t, client, string(role), role)
+		c.handleCommand("/help")
+		client.runQueued(t)
+
+		help, ok := client.lastSent().(*packets.Packet_Chat)
+		if !ok {
+			t.Fatalf("%s: expected the help, got %v", role, client.lastSent())
+		}
+		for name, command := range chatCommands {
+			listed := strings.Contains(help.Chat.Msg, command.usage)
+			if allowed := role.AtLeast(command.role); listed != allowed {
+				t.Errorf("%s: expected /%s to be listed: %t", role, name, allowed)
+			}
+		}
+	}
+}
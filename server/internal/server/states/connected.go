@@ -2,23 +2,42 @@ package states
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"log"
 	"server/internal/server"
 	"server/internal/server/db"
+	"server/internal/server/moderation"
+	"server/internal/server/names"
 	"server/internal/server/objects"
+	"server/internal/server/rating"
+	"server/internal/server/storage"
 	"server/pkg/packets"
-	"strings"
-
-	"golang.org/x/crypto/bcrypt"
+	"time"
 )
 
 type Connected struct {
 	client  server.ClientInterfacer
 	logger  *log.Logger
 	queries *db.Queries
-	dbCtx   context.Context
+	users   storage.Users
+}
+
+var (
+	errUserExists     = storage.ErrUserExists
+	errNameInUse      = errors.New("the name is in use by a guest")
+	errNameTaken      = errors.New("no free name like the one asked for")
+	errAlreadyPlaying = errors.New("the user is already playing on another connection")
+)
+
+// Returned when the user, the name or the client's address is banned
+type bannedError struct {
+	ban *db.Ban
+}
+
+func (e *bannedError) Error() string {
+	return fmt.Sprintf("banned by ban %d: %s", e.ban.ID, e.ban.Reason)
 }
 
 func (c *Connected) Name() string {
@@ -30,10 +49,12 @@ func (c *Connected) SetClient(client server.ClientInterfacer) {
 	loggingPrefix := fmt.Sprintf("Client %d (%s)", client.Id(), c.Name())
 	c.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
 	c.queries = client.DbTx().Queries
-	c.dbCtx = client.DbTx().Ctx
+	c.users = client.DbTx().Users
 }
 
 func (c *Connected) OnEnter() {
+	// Players who log out give up their name
+	c.client.Names().Release(c.client.Id())
 	c.client.SocketSend(packets.NewId(c.client.Id()))
 }
 
@@ -45,6 +66,14 @@ func (c *Connected) HandleMessage(senderId uint64, message packets.Msg) {
 		c.handleGuestLoginRequest(senderId, message)
 	case *packets.Packet_RegisterRequest:
 		c.handleRegisterRequest(senderId, message)
+	case *packets.Packet_ChangePasswordRequest:
+		c.handleChangePasswordRequest(senderId, message)
+	case *packets.Packet_ResetPasswordRequest:
+		c.handleResetPasswordRequest(senderId, message)
+	case *packets.Packet_DeleteAccountRequest:
+		c.handleDeleteAccountRequest(senderId, message)
+	case *packets.Packet_HistoryRequest:
+		c.handleHistoryRequest(senderId, message)
 	}
 }
 
@@ -56,13 +85,52 @@ func (c *Connected) handleGuestLoginRequest(senderId uint64, message *packets.Pa
 
 	username := message.GuestLoginRequest.Username
 	c.logger.Printf("Received guest login request from %d for username %s", senderId, username)
-	c.client.SocketSend(packets.NewOkResponse())
 
-	c.client.SetState(&InGame{
-		player: &objects.Player{
-			Name:  username,
+	if err := validateUsername(username); err != nil {
+		c.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Invalid username: %v", err)))
+		return
+	}
+
+	var name string
+	var muted time.Duration
+	c.runJob("log in as a guest", username, func(ctx context.Context) error {
+		if err := c.checkBan(ctx, 0, username); err != nil {
+			return err
+		}
+
+		// Chatting doesn't look up mutes, so it's done once here
+		var err error
+		if muted, err = moderation.MuteRemaining(ctx, c.queries, 0, username, c.client.IP()); err != nil {
+			return err
+		}
+
+		// Guests get a number added to their name if someone else is using it, or a registered user goes by it
+		name, err = c.client.Names().ClaimGuest(username, c.client.Id(), func(name string) (bool, error) {
+			_, err := c.users.GetUserByUsername(ctx, name)
+			if !errors.Is(err, sql.ErrNoRows) {
+				return err == nil, err
+			}
+			_, err = c.users.GetUserProfileByDisplayName(ctx, db.GetUserProfileByDisplayNameParams{DisplayName: name})
+			if errors.Is(err, sql.ErrNoRows) {
+				return false, nil
+			}
+			return err == nil, err
+		})
+		if err != nil {
+			c.logger.Printf("Failed to find a free name for guest %s: %v", username, err)
+			return errNameTaken
+		}
+		return nil
+	}, func() {
+		if name != username {
+			c.logger.Printf("Guest %s plays as %s since the name is taken", username, name)
+		}
+		c.client.MuteStatus().Set(time.Now().Add(muted))
+
+		c.play(&objects.Player{
+			Name:  name,
 			Color: int32(message.GuestLoginRequest.Color),
-		},
+		}, 0, rating.Initial, message.GuestLoginRequest.Region)
 	})
 }
 
@@ -73,30 +141,55 @@ func (c *Connected) handleLoginRequest(senderId uint64, message *packets.Packet_
 	}
 
 	username := message.LoginRequest.Username
-	failMessage := packets.NewDenyResponse("Invalid username or password")
 
-	user, err := c.queries.GetUserByUsername(c.dbCtx, username)
-	if err != nil {
-		c.logger.Printf("Error getting user by username: %v", err)
-		c.client.SocketSend(failMessage)
-		return
-	}
+	var user db.User
+	var profile db.UserProfile
+	var userRating float64
+	var muted time.Duration
+	c.runJob("log in", username, func(ctx context.Context) error {
+		var err error
+		user, err = c.checkPassword(ctx, username, message.LoginRequest.Password)
+		if err != nil {
+			return err
+		}
 
-	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(message.LoginRequest.Password))
-	if err != nil {
-		c.logger.Printf("Error comparing passwords: %v", err)
-		c.client.SocketSend(failMessage)
-		return
-	}
+		if err := c.checkBan(ctx, user.ID, user.Username); err != nil {
+			return err
+		}
 
-	c.logger.Printf("User %s logged in", username)
-	c.client.SocketSend(packets.NewOkResponse())
+		// Chatting doesn't look up mutes, so it's done once here
+		if muted, err = moderation.MuteRemaining(ctx, c.queries, user.ID, user.Username, c.client.IP()); err != nil {
+			return err
+		}
 
-	c.client.SetState(&InGame{
-		player: &objects.Player{
-			Name:  username,
-			Color: int32(message.LoginRequest.Color),
-		},
+		// The user can still play without their profile, and with the default rating
+		profile, err = loadProfile(ctx, c.client, user.ID)
+		if err != nil {
+			c.logger.Printf("Error getting the profile of user %s: %v", user.Username, err)
+		}
+		if userRating, err = loadRating(ctx, c.users, user.ID); err != nil {
+			c.logger.Printf("Error getting the rating of user %s: %v", user.Username, err)
+			userRating = rating.Initial
+		}
+		return nil
+	}, func() {
+		if !c.client.Names().Claim(user.Username, c.client.Id()) {
+			c.denyAccountRequest("log in", user.Username, errAlreadyPlaying)
+			return
+		}
+
+		c.logger.Printf("User %s logged in", user.Username)
+		c.client.MuteStatus().Set(time.Now().Add(muted))
+		// The player's appearance comes from their profile unless they chose a colour when logging in
+		player := &objects.Player{
+			Name:   c.displayName(user, profile),
+			Color:  int32(message.LoginRequest.Color),
+			SkinId: uint32(profile.SkinID),
+		}
+		if player.Color == 0 {
+			player.Color = int32(profile.Color)
+		}
+		c.play(player, user.ID, userRating, message.LoginRequest.Region)
 	})
 }
 
@@ -106,7 +199,7 @@ func (c *Connected) handleRegisterRequest(senderId uint64, message *packets.Pack
 		return
 	}
 
-	username := strings.ToLower(message.RegisterRequest.Username)
+	username := names.Normalize(message.RegisterRequest.Username)
 	err := validateUsername(message.RegisterRequest.Username)
 	if err != nil {
 		reason := fmt.Sprintf("Invalid username: %v", err)
@@ -115,48 +208,91 @@ func (c *Connected) handleRegisterRequest(senderId uint64, message *packets.Pack
 		return
 	}
 
-	_, err = c.queries.GetUserByUsername(c.dbCtx, username)
-	if err == nil {
-		c.logger.Printf("User already exists: %s", username)
-		c.client.SocketSend(packets.NewDenyResponse("User already exists"))
-		return
-	}
+	c.runJob("register", username, func(ctx context.Context) error {
+		// Hash first to keep the transaction short
+		passwordHash, err := hashPassword(message.RegisterRequest.Password)
+		if err != nil {
+			return err
+		}
 
-	genericFailMessage := packets.NewDenyResponse("Error registering user (internal server error) - please try again later")
+		// Nobody can take the username between checking it's free and adding the user, a registration racing this
+		// one on another server makes CreateUser fail with errUserExists
+		return c.client.DbTx().WithUsersTx(func(users storage.Users) error {
+			_, err := users.GetUserByUsername(ctx, username)
+			if err == nil {
+				return errUserExists
+			}
+			if !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
 
-	// Add new user
-	passwordHash, err := bcrypt.GenerateFromPassword([]byte(message.RegisterRequest.Password), bcrypt.DefaultCost)
-	if err != nil {
-		c.logger.Printf("Failed to hash password: %s", username)
-		c.client.SocketSend(genericFailMessage)
-		return
-	}
+			// A guest could be playing under the name right now
+			if c.client.Names().InUse(username) {
+				return errNameInUse
+			}
+
+			_, err = users.CreateUser(ctx, db.CreateUserParams{
+				Username:     username,
+				PasswordHash: passwordHash,
+			})
+			return err
+		})
+	}, func() {
+		c.client.SocketSend(packets.NewOkResponse())
 
-	_, err = c.queries.CreateUser(c.dbCtx, db.CreateUserParams{
-		Username:     username,
-		PasswordHash: string(passwordHash),
+		c.logger.Printf("User %s registered successfully", username)
 	})
+}
 
+// Run work on a worker, keeping the read pump free for the client's other packets, then finish the request with done
+// if the client is still in this state by then. Failures, including a full queue, are turned into deny responses.
+func (c *Connected) runJob(action string, username string, work func(ctx context.Context) error, done func()) {
+	err := c.client.Workers().Submit(c.client, work, func(err error) {
+		if err != nil {
+			c.denyAccountRequest(action, username, err)
+			return
+		}
+		done()
+	})
 	if err != nil {
-		c.logger.Printf("Failed to create user %s: %v", username, err)
-		c.client.SocketSend(genericFailMessage)
-		return
+		c.denyAccountRequest(action, username, err)
 	}
+}
 
-	c.client.SocketSend(packets.NewOkResponse())
+// The name a registered user plays under: their display name, unless another player is using it right now
+func (c *Connected) displayName(user db.User, profile db.UserProfile) string {
+	if profile.DisplayName == "" || !c.client.Names().Claim(profile.DisplayName, c.client.Id()) {
+		return user.Username
+	}
+	return profile.DisplayName
+}
 
-	c.logger.Printf("User %s registered successfully", username)
+// Check whether the user, the name or the client's address is banned
+func (c *Connected) checkBan(ctx context.Context, userId int64, username string) error {
+	ban, err := moderation.FindBan(ctx, c.queries, userId, username, c.client.IP())
+	if err != nil {
+		return err
+	}
+	if ban != nil {
+		return &bannedError{ban: ban}
+	}
+	return nil
 }
 
 func validateUsername(username string) error {
-	if len(username) < 3 {
-		return errors.New("username must be at least 3 characters long")
+	if len(username) < names.MinLength {
+		return fmt.Errorf("username must be at least %d characters long", names.MinLength)
+	}
+	if len(username) > names.MaxLength {
+		return fmt.Errorf("username must be at most %d characters long", names.MaxLength)
 	}
-	if len(username) > 20 {
-		return errors.New("username must be at most 20 characters long")
+	for _, char := range username {
+		if !(char >= 'a' && char <= 'z' || char >= 'A' && char <= 'Z' || char >= '0' && char <= '9' || char == '_' || char == '-') {
+			return errors.New("only letters, digits, underscores and hyphens are allowed")
+		}
 	}
-	if username != strings.TrimSpace(username) {
-		return errors.New("leading or trailing whitespace")
+	if names.IsReserved(username) {
+		return errors.New("that name is reserved")
 	}
 
 	return nil
@@ -0,0 +1,200 @@
+package states
+
+import (
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"time"
+)
+
+// Players who were consumed wait in this state until they ask to respawn, which they can do once the cooldown is over
+type Dead struct {
+	client server.ClientInterfacer
+	player *objects.Player
+	userId int64
+	death  *packets.DeathMessage
+	diedAt time.Time
+	// The round the player died in
+	round uint32
+	// The room the party moved to while the player was waiting to respawn, they follow it once they do
+	partyRoom string
+	logger    *log.Logger
+}
+
+func (d *Dead) Name() string {
+	return "Dead"
+}
+
+func (d *Dead) UserId() int64 {
+	return d.userId
+}
+
+func (d *Dead) SetClient(client server.ClientInterfacer) {
+	d.client = client
+	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), d.Name())
+	d.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
+}
+
+func (d *Dead) OnEnter() {
+	d.diedAt = time.Now()
+	d.round = d.client.SharedGameObjects().Round.Number()
+	d.death.RespawnCooldown = d.client.Config().RespawnCooldown.Seconds()
+	d.client.SocketSend(&packets.Packet_Death{Death: d.death})
+}
+
+func (d *Dead) HandleMessage(senderId uint64, message packets.Msg) {
+	if d.requests().handle(senderId, message) {
+		return
+	}
+
+	switch message := message.(type) {
+	case *packets.Packet_RespawnRequest:
+		d.handleRespawnRequest(senderId, message)
+	case *packets.Packet_RoundState:
+		d.handleRoundState(senderId, message)
+	case *packets.Packet_Disconnect:
+		d.handleDisconnect(senderId, message)
+	case *packets.Packet_JoinFriendRequest:
+		d.handleJoinFriendRequest(senderId, message)
+	default:
+		forwardWorldUpdate(d.client, senderId, message)
+	}
+}
+
+// The packets handled the same way in every state of a logged-in player
+func (d *Dead) requests() userRequests {
+	return userRequests{client: d.client, player: d.player, userId: d.userId, logger: d.logger}
+}
+
+func (d *Dead) HandleLocal(message server.LocalMsg) {
+	switch message := message.(type) {
+	case *server.MoveRoom:
+		d.handleMoveRoom(message)
+	}
+}
+
+func (d *Dead) handleRespawnRequest(senderId uint64, _ *packets.Packet_RespawnRequest) {
+	if senderId != d.client.Id() {
+		d.logger.Printf("Received respawn request from %d, but I'm %d", senderId, d.client.Id())
+		return
+	}
+
+	if room := d.partyRoom; room != "" {
+		if !d.cooledDown() {
+			return
+		}
+		d.partyRoom = ""
+		if followParty(d.client, d.player, d.userId, &server.MoveRoom{Room: room}, d.logger) {
+			d.client.SocketSend(packets.NewOkResponse())
+			return
+		}
+	}
+
+	if !d.canRespawn() {
+		return
+	}
+
+	d.client.SocketSend(packets.NewOkResponse())
+	d.respawn()
+}
+
+// Respawn next to a friend, in the friend's room
+func (d *Dead) handleJoinFriendRequest(senderId uint64, message *packets.Packet_JoinFriendRequest) {
+	if !d.requests().fromSelf(senderId, message) {
+		return
+	}
+
+	joinFriend(d.client, d.userId, message, d.logger, func(friendName string, entry server.PresenceEntry, room *server.Room) {
+		if room != d.client.Room() {
+			// A round running in this room doesn't matter, they spectate if one is running in the friend's
+			if !d.cooledDown() {
+				return
+			}
+			joinFriendRoom(d.client, d.player, d.userId, friendName, entry, room, d.logger)
+			return
+		}
+		if _, playing := d.client.SharedGameObjects().Players.Get(entry.ClientId); !playing {
+			d.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("%s isn't playing right now", friendName)))
+			return
+		}
+		if !d.canRespawn() {
+			return
+		}
+
+		d.logger.Printf("Joining %s", friendName)
+		d.client.SocketSend(packets.NewOkResponse())
+		d.respawnNear(entry.ClientId)
+	})
+}
+
+// Whether the player may respawn now, telling them why not if they can't
+func (d *Dead) canRespawn() bool {
+	if d.client.SharedGameObjects().Round.Running() {
+		d.client.SocketSend(packets.NewDenyResponse("You can respawn when the next round starts"))
+		return false
+	}
+
+	return d.cooledDown()
+}
+
+// Whether the respawn cooldown is over, which it has to be before the player leaves this state for another room too
+func (d *Dead) cooledDown() bool {
+	if remaining := d.client.Config().RespawnCooldown - time.Since(d.diedAt); remaining > 0 {
+		d.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("You can respawn in %.1f seconds", remaining.Seconds())))
+		return false
+	}
+	return true
+}
+
+func (d *Dead) handleRoundState(senderId uint64, message *packets.Packet_RoundState) {
+	d.client.SocketSendAs(message, senderId)
+
+	// Players knocked out of a round get to play again as soon as the next lobby opens, the lobby they died in is
+	// announced every tick and doesn't count. The room's goroutine announces it, the client's own respawns.
+	if message.RoundState.Phase == packets.RoundPhase_ROUND_PHASE_LOBBY && message.RoundState.Round != d.round {
+		server.QueueTask(d.client, d, d.respawn)
+	}
+}
+
+func (d *Dead) respawn() {
+	d.respawnNear(0)
+}
+
+// Respawn close to the player of another client, or anywhere if the client id is 0
+func (d *Dead) respawnNear(clientId uint64) {
+	d.logger.Println("Respawning")
+	d.client.SetState(&InGame{
+		player: &objects.Player{
+			Name:   d.player.Name,
+			Color:  d.player.Color,
+			SkinId: d.player.SkinId,
+		},
+		userId: d.userId,
+		near:   clientId,
+	})
+}
+
+// Players still waiting for the respawn cooldown follow the party when they respawn
+func (d *Dead) handleMoveRoom(message *server.MoveRoom) {
+	if time.Since(d.diedAt) < d.client.Config().RespawnCooldown {
+		d.logger.Printf("Following the party into room %s once the player respawns", message.Room)
+		d.partyRoom = message.Room
+		return
+	}
+	followParty(d.client, d.player, d.userId, message, d.logger)
+}
+
+func (d *Dead) handleDisconnect(senderId uint64, message *packets.Packet_Disconnect) {
+	if senderId == d.client.Id() {
+		d.client.Broadcast(message)
+		d.client.SetState(&Connected{})
+		return
+	}
+
+	d.client.SocketSendAs(message, senderId)
+}
+
+func (d *Dead) OnExit() {
+}
@@ -0,0 +1,129 @@
+package states
+
+import (
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"testing"
+	"time"
+)
+
+func newDeadClient(t *testing.T, config server.Config) (*testClient, *Dead) {
+	t.Helper()
+	client := newTestClient(t, config)
+	dead := &Dead{
+		player: &objects.Player{Name: "bob", Color: 42, Radius: 80},
+		death:  &packets.DeathMessage{KillerName: "alice"},
+	}
+	client.enter(dead)
+	return client, dead
+}
+
+func TestDeadShowsDeathScreen(t *testing.T) {
+	config := newTestConfig()
+	config.RespawnCooldown = 3 * time.Second
+	client, _ := newDeadClient(t, config)
+
+	death, ok := client.lastSent().(*packets.Packet_Death)
+	if !ok {
+		t.Fatalf("expected the death screen, got %v", client.lastSent())
+	}
+	if death.Death.KillerName != "alice" || death.Death.RespawnCooldown != 3 {
+		t.Errorf("expected the killer and the cooldown, got %v", death.Death)
+	}
+}
+
+func TestDeadRespawnsAfterCooldown(t *testing.T) {
+	config := newTestConfig()
+	config.RespawnCooldown = time.Minute
+	client, dead := newDeadClient(t, config)
+	request := packets.Packet_RespawnRequest{RespawnRequest: &packets.RespawnRequestMessage{}}
+
+	dead.HandleMessage(client.id+1, &request)
+	if client.state != dead {
+		t.Fatal("expected requests from other clients to be ignored")
+	}
+
+	dead.HandleMessage(client.id, &request)
+	client.expectDeny(t, "You can respawn in")
+	if client.state != dead {
+		t.Fatal("expected the player to stay dead during the cooldown")
+	}
+
+	dead.diedAt = time.Now().Add(-time.Minute)
+	dead.HandleMessage(client.id, &request)
+	client.expectOk(t)
+	game, ok := client.state.(*InGame)
+	if !ok {
+		t.Fatalf("expected the player to be back in the game, got %T", client.state)
+	}
+	if game.player.Name != "bob" || game.player.Color != 42 || game.player.Radius != 0 {
+		t.Errorf("expected a fresh player with the same name and colour, got %+v", game.player)
+	}
+}
+
+func TestDeadWaitsForNextRound(t *testing.T) {
+	config := newTestConfig()
+	config.Mode = server.ModeRoyale
+	config.RespawnCooldown = 0
+	client, dead := newDeadClient(t, config)
+	round := client.SharedGameObjects().Round
+
+	// The room opens the first lobby as it starts, a round can only start after that
+	for round.Number() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	round.Start()
+	dead.HandleMessage(client.id, &packets.Packet_RespawnRequest{RespawnRequest: &packets.RespawnRequestMessage{}})
+	client.expectDeny(t, "next round")
+	if client.state != dead {
+		t.Fatal("expected the player to stay dead while the round is running")
+	}
+
+	// The lobby the player died in doesn't count, the next one does
+	lobby := func(number uint32) *packets.Packet_RoundState {
+		return &packets.Packet_RoundState{RoundState: &packets.RoundStateMessage{Round: number, Phase: packets.RoundPhase_ROUND_PHASE_LOBBY}}
+	}
+	dead.HandleMessage(0, lobby(dead.round))
+	if len(client.queued) != 0 {
+		t.Error("expected the lobby of the round the player died in not to respawn them")
+	}
+	dead.HandleMessage(0, lobby(dead.round+1))
+	client.runQueued(t)
+	if _, ok := client.state.(*InGame); !ok {
+		t.Errorf("expected the player to respawn when the next lobby opens, got %T", client.state)
+	}
+}
+
+func TestDeadWatchesTheGame(t *testing.T) {
+	client, dead := newDeadClient(t, newTestConfig())
+	client.clearSent()
+
+	spore := &packets.Packet_Spore{Spore: &packets.SporeMessage{Id: 1}}
+	dead.HandleMessage(client.id, spore)
+	if client.lastSent() != nil {
+		t.Errorf("expected the player's own updates not to be sent back, got %v", client.lastSent())
+	}
+	dead.HandleMessage(client.id+1, spore)
+	if client.lastSent() != spore {
+		t.Errorf("expected to see what the others are doing, got %v", client.lastSent())
+	}
+}
+
+func TestDeadLeaves(t *testing.T) {
+	client, dead := newDeadClient(t, newTestConfig())
+
+	disconnect := &packets.Packet_Disconnect{Disconnect: &packets.DisconnectMessage{Reason: "bye"}}
+	dead.HandleMessage(client.id+1, disconnect)
+	if client.state != dead || client.lastSent() != disconnect {
+		t.Fatal("expected another player leaving to be passed on")
+	}
+
+	dead.HandleMessage(client.id, disconnect)
+	if len(client.broadcast) != 1 || client.broadcast[0] != disconnect {
+		t.Errorf("expected the others to be told the player left, got %v", client.broadcast)
+	}
+	if _, ok := client.state.(*Connected); !ok {
+		t.Errorf("expected the player to be logged out, got %T", client.state)
+	}
+}
@@ -0,0 +1,268 @@
+package states
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/internal/server/names"
+	"server/internal/server/objects"
+	"server/internal/server/storage"
+	"server/pkg/packets"
+)
+
+var (
+	errNoSuchUser       = errors.New("there is no user with that name")
+	errFriendSelf       = errors.New("you can't be your own friend")
+	errAlreadyFriends   = errors.New("you are friends already")
+	errAlreadyRequested = errors.New("you asked them already, they have to accept")
+	errNotFriends       = errors.New("you aren't friends")
+)
+
+// Ask another user to be friends, or accept if they asked first
+func addFriend(client server.ClientInterfacer, userId int64, message *packets.Packet_AddFriendRequest, logger *log.Logger) {
+	if userId == 0 {
+		client.SocketSend(packets.NewDenyResponse("Guests can't have friends, register to add some"))
+		return
+	}
+
+	var user, friend db.User
+	var accepted bool
+	runUserJob(client, "add friend", logger, func(ctx context.Context) error {
+		var err error
+		if user, friend, err = getFriend(ctx, client, userId, message.AddFriendRequest.Username); err != nil {
+			return err
+		}
+
+		return client.DbTx().WithUsersTx(func(users storage.Users) error {
+			existing, err := users.GetFriendship(ctx, db.GetFriendshipParams{UserID: user.ID, FriendID: friend.ID})
+			if err == nil {
+				if existing.Status == storage.FriendshipAccepted {
+					return errAlreadyFriends
+				}
+				return errAlreadyRequested
+			}
+			if !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+
+			updated, err := users.AcceptFriendship(ctx, db.AcceptFriendshipParams{UserID: friend.ID, FriendID: user.ID})
+			if err != nil {
+				return err
+			}
+			accepted = updated > 0
+
+			status := storage.FriendshipPending
+			if accepted {
+				status = storage.FriendshipAccepted
+			}
+			return users.CreateFriendship(ctx, db.CreateFriendshipParams{UserID: user.ID, FriendID: friend.ID, Status: status})
+		})
+	}, func() {
+		logger.Printf("User %d added %s as a friend (accepted: %t)", userId, friend.Username, accepted)
+		client.SocketSend(packets.NewOkResponse())
+
+		if !accepted {
+			client.SocketSend(packets.NewFriend(friend.Username, packets.FriendshipState_FRIENDSHIP_STATE_REQUEST_SENT, false, "", ""))
+			sendToUser(client, friend.ID, packets.NewFriend(user.Username, packets.FriendshipState_FRIENDSHIP_STATE_REQUEST_RECEIVED, false, "", ""))
+			return
+		}
+
+		client.SocketSend(friendPresence(client, friend))
+		sendToUser(client, friend.ID, friendPresence(client, user))
+	})
+}
+
+// Stop being friends, or turn down or take back a friend request
+func removeFriend(client server.ClientInterfacer, userId int64, message *packets.Packet_RemoveFriendRequest, logger *log.Logger) {
+	if userId == 0 {
+		client.SocketSend(packets.NewDenyResponse("Guests can't have friends, register to add some"))
+		return
+	}
+
+	var user, friend db.User
+	runUserJob(client, "remove friend", logger, func(ctx context.Context) error {
+		var err error
+		if user, friend, err = getFriend(ctx, client, userId, message.RemoveFriendRequest.Username); err != nil {
+			return err
+		}
+
+		return client.DbTx().WithUsersTx(func(users storage.Users) error {
+			found := false
+			for _, params := range []db.GetFriendshipParams{
+				{UserID: user.ID, FriendID: friend.ID},
+				{UserID: friend.ID, FriendID: user.ID},
+			} {
+				_, err := users.GetFriendship(ctx, params)
+				if err == nil {
+					found = true
+				} else if !errors.Is(err, sql.ErrNoRows) {
+					return err
+				}
+			}
+			if !found {
+				return errNotFriends
+			}
+
+			if err := users.DeleteFriendship(ctx, db.DeleteFriendshipParams{UserID: user.ID, FriendID: friend.ID}); err != nil {
+				return err
+			}
+			return users.DeleteFriendship(ctx, db.DeleteFriendshipParams{UserID: friend.ID, FriendID: user.ID})
+		})
+	}, func() {
+		logger.Printf("User %d removed %s as a friend", userId, friend.Username)
+		client.SocketSend(packets.NewOkResponse())
+		client.SocketSend(packets.NewFriend(friend.Username, packets.FriendshipState_FRIENDSHIP_STATE_NONE, false, "", ""))
+		sendToUser(client, friend.ID, packets.NewFriend(user.Username, packets.FriendshipState_FRIENDSHIP_STATE_NONE, false, "", ""))
+	})
+}
+
+// Send the user their friends, whom they asked to be friends and who asked them
+func sendFriendList(client server.ClientInterfacer, userId int64, logger *log.Logger) {
+	if userId == 0 {
+		client.SocketSend(packets.NewDenyResponse("Guests can't have friends, register to add some"))
+		return
+	}
+
+	var friends []db.ListFriendsByUserRow
+	var requests []db.ListFriendRequestsToUserRow
+	runUserJob(client, "list friends", logger, func(ctx context.Context) error {
+		var err error
+		if friends, err = client.DbTx().Users.ListFriendsByUser(ctx, userId); err != nil {
+			return err
+		}
+		requests, err = client.DbTx().Users.ListFriendRequestsToUser(ctx, userId)
+		return err
+	}, func() {
+		messages := make([]*packets.FriendMessage, 0, len(friends)+len(requests))
+		for _, friend := range friends {
+			message := &packets.FriendMessage{Username: friend.Username, State: packets.FriendshipState_FRIENDSHIP_STATE_REQUEST_SENT}
+			if friend.Status == storage.FriendshipAccepted {
+				message.State = packets.FriendshipState_FRIENDSHIP_STATE_FRIENDS
+				if entry, online := client.Presence().Get(friend.FriendID); online {
+					message.Online, message.Room, message.Status = true, entry.Room, entry.Status
+				}
+			}
+			messages = append(messages, message)
+		}
+		for _, request := range requests {
+			messages = append(messages, &packets.FriendMessage{Username: request.Username, State: packets.FriendshipState_FRIENDSHIP_STATE_REQUEST_RECEIVED})
+		}
+		client.SocketSend(packets.NewFriendList(messages))
+	})
+}
+
+// Find out where a friend is playing, so the user can join them there. What joining means depends on the state the
+// user is in, join is called with the friend's client and room once they're found to be online.
+func joinFriend(client server.ClientInterfacer, userId int64, message *packets.Packet_JoinFriendRequest, logger *log.Logger, join func(friendName string, entry server.PresenceEntry, room *server.Room)) {
+	if userId == 0 {
+		client.SocketSend(packets.NewDenyResponse("Guests can't have friends, register to add some"))
+		return
+	}
+
+	var friend db.User
+	runUserJob(client, "join friend", logger, func(ctx context.Context) error {
+		var user db.User
+		var err error
+		if user, friend, err = getFriend(ctx, client, userId, message.JoinFriendRequest.Username); err != nil {
+			return err
+		}
+
+		friendship, err := client.DbTx().Users.GetFriendship(ctx, db.GetFriendshipParams{UserID: user.ID, FriendID: friend.ID})
+		if errors.Is(err, sql.ErrNoRows) || (err == nil && friendship.Status != storage.FriendshipAccepted) {
+			return errNotFriends
+		}
+		return err
+	}, func() {
+		entry, online := client.Presence().Get(friend.ID)
+		if !online {
+			client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("%s isn't online", friend.Username)))
+			return
+		}
+		room, exists := client.Rooms().Get(entry.Room)
+		if !exists {
+			client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("%s isn't playing right now", friend.Username)))
+			return
+		}
+		join(friend.Username, entry, room)
+	})
+}
+
+// Move the player into the room a friend is playing in, next to the friend
+func joinFriendRoom(client server.ClientInterfacer, player *objects.Player, userId int64, friendName string, entry server.PresenceEntry, room *server.Room, logger *log.Logger) {
+	if !room.Fits(1) {
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Can't join %s in %s: %v", friendName, room.Name, server.ErrRoomFull)))
+		return
+	}
+
+	logger.Printf("Joining %s in room %s", friendName, room.Name)
+	client.SocketSend(packets.NewOkResponse())
+	moveToRoom(client, room, player, userId, entry.ClientId)
+}
+
+// Look up the user and the user they named as a friend
+func getFriend(ctx context.Context, client server.ClientInterfacer, userId int64, friendName string) (db.User, db.User, error) {
+	user, err := client.DbTx().Users.GetUserByID(ctx, userId)
+	if err != nil {
+		return db.User{}, db.User{}, err
+	}
+
+	friend, err := client.DbTx().Users.GetUserByUsername(ctx, names.Normalize(friendName))
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.User{}, db.User{}, errNoSuchUser
+	}
+	if err != nil {
+		return db.User{}, db.User{}, err
+	}
+
+	if friend.ID == user.ID {
+		return db.User{}, db.User{}, errFriendSelf
+	}
+	return user, friend, nil
+}
+
+// How a friend shows up in the user's list, including what they're doing if they're online
+func friendPresence(client server.ClientInterfacer, friend db.User) packets.Msg {
+	entry, online := client.Presence().Get(friend.ID)
+	return packets.NewFriend(friend.Username, packets.FriendshipState_FRIENDSHIP_STATE_FRIENDS, online, entry.Room, entry.Status)
+}
+
+// Send a message to a user if they're online
+func sendToUser(client server.ClientInterfacer, userId int64, message packets.Msg) {
+	if entry, online := client.Presence().Get(userId); online {
+		if peer, exists := client.Peer(entry.ClientId); exists {
+			peer.SocketSend(message)
+		}
+	}
+}
+
+// Run a request about users on a worker, e.g. for friends or ratings, telling the client why it failed if it did
+func runUserJob(client server.ClientInterfacer, action string, logger *log.Logger, work func(ctx context.Context) error, done func()) {
+	deny := func(err error) {
+		switch {
+		case errors.Is(err, errNoSuchUser), errors.Is(err, errFriendSelf), errors.Is(err, errAlreadyFriends),
+			errors.Is(err, errAlreadyRequested), errors.Is(err, errNotFriends), errors.Is(err, errDisplayNameTaken):
+			client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Can't %s: %v", action, err)))
+		case errors.Is(err, server.ErrWorkersBusy), errors.Is(err, context.DeadlineExceeded):
+			logger.Printf("Refusing to %s: %v", action, err)
+			client.SocketSend(packets.NewDenyResponse("The server is busy - please try again later"))
+		default:
+			logger.Printf("Failed to %s: %v", action, err)
+			client.SocketSend(packets.NewDenyResponse("Error processing the request (internal server error) - please try again later"))
+		}
+	}
+
+	err := client.Workers().Submit(client, work, func(err error) {
+		if err != nil {
+			deny(err)
+			return
+		}
+		done()
+	})
+	if err != nil {
+		deny(err)
+	}
+}
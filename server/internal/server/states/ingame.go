@@ -6,22 +6,52 @@ import (
 	"log"
 	"math"
 	"server/internal/server"
+	"server/internal/server/achievements"
+	"server/internal/server/anticheat"
 	"server/internal/server/objects"
 	"server/pkg/packets"
+	"sync/atomic"
 	"time"
 )
 
 type InGame struct {
 	client                 server.ClientInterfacer
 	player                 *objects.Player
+	userId                 int64
+	round                  uint32
+	spawnedAt              time.Time
+	bestRank               uint32
 	cancelPlayerUpdateLoop context.CancelFunc
 	logger                 *log.Logger
+	// Whether the player just logged in, rather than respawned, so they need to catch up on the chat
+	joining bool
+	// How this life went, recorded as a session once it's over
+	peakMass     float64
+	sporesEaten  int64
+	playersEaten int64
+	endCause     string
+	killerName   string
+	// The mass of the biggest player eaten during this life
+	largestKill float64
+	// The ratings of the players eaten during this life, which count towards the player's rating
+	killRatings []float64
+	// nil until the achievements the user already has are loaded, and for guests
+	achievements atomic.Pointer[achievements.Tracker]
+	// The client of a friend the player joined, they spawn close to them
+	near uint64
+	// The spores the magnet moved since they were last sent, and when that was
+	pulledSpores   map[uint64]struct{}
+	pulledSporesAt time.Time
 }
 
 func (s *InGame) Name() string {
 	return "InGame"
 }
 
+func (g *InGame) UserId() int64 {
+	return g.userId
+}
+
 func (g *InGame) SetClient(client server.ClientInterfacer) {
 	g.client = client
 	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), g.Name())
@@ -34,31 +64,85 @@ func (g *InGame) OnEnter() {
 
 	// Initial player properties
 	g.player.X, g.player.Y = objects.SpawnCoords(g.player.Radius, g.client.SharedGameObjects().Players, nil)
+	if friend, exists := g.client.SharedGameObjects().Players.Get(g.near); g.near != 0 && exists {
+		g.player.X, g.player.Y = objects.SpawnCoordsNear(friend.X, friend.Y, friend.Radius+friendSpawnDistance, 20, g.client.SharedGameObjects().Players, nil)
+	}
 	g.player.Radius = 20.0
 	g.player.Speed = 150.0
 
+	// In team mode the team colour takes precedence over the colour the player picked
+	teams := g.client.SharedGameObjects().Teams
+	if teams.Enabled() {
+		party, _ := g.client.Parties().Get(g.client.Id())
+		g.player.Team = teams.Assign(g.client.Id(), g.player.Name, party.Mates(g.client.Id()))
+		g.player.Color = teams.Color(g.player.Team)
+	}
+
+	g.round = g.client.SharedGameObjects().Round.Number()
+	g.spawnedAt = time.Now()
+	g.updateRank()
+	g.client.AntiCheat().Identify(g.userId, g.player.Name)
+
 	g.client.SocketSend(packets.NewPlayer(g.client.Id(), g.player))
+	g.loadAchievements()
+
+	if g.joining {
+		sendChatHistory(g.client)
+	}
 
-	// Send the spores to the client in the background
+	// Send the spores and power-ups to the client in the background
 	go g.sendInitialSpores(20, 50*time.Millisecond)
+	go g.sendInitialPowerUps()
 }
 
 func (g *InGame) HandleMessage(senderId uint64, message packets.Msg) {
+	if g.requests().handle(senderId, message) {
+		return
+	}
+
 	switch message := message.(type) {
 	case *packets.Packet_Player:
 		g.handlePlayerUpdate(senderId, message)
 	case *packets.Packet_PlayerDirection:
 		g.handlePlayerDirection(senderId, message)
-	case *packets.Packet_Chat:
-		g.handleChat(senderId, message)
 	case *packets.Packet_SporeConsumed:
 		g.handleSporeConsumed(senderId, message)
 	case *packets.Packet_PlayerConsumed:
 		g.handlePlayerConsumed(senderId, message)
 	case *packets.Packet_Spore:
 		g.handleSpore(senderId, message)
+	case *packets.Packet_SporesBatch:
+		g.handleSporesBatch(senderId, message)
 	case *packets.Packet_Disconnect:
 		g.handleDisconnect(senderId, message)
+	case *packets.Packet_TeamScoreboard:
+		g.handleTeamScoreboard(senderId, message)
+	case *packets.Packet_RoundState:
+		g.handleRoundState(senderId, message)
+	case *packets.Packet_SafeZone:
+		g.handleSafeZone(senderId, message)
+	case *packets.Packet_WorldReset:
+		g.handleWorldReset(senderId, message)
+	case *packets.Packet_PowerUp:
+		g.handlePowerUp(senderId, message)
+	case *packets.Packet_PowerUpConsumed:
+		g.handlePowerUpConsumed(senderId, message)
+	case *packets.Packet_PlayerEffects:
+		g.handlePlayerEffects(senderId, message)
+	case *packets.Packet_JoinFriendRequest:
+		g.handleJoinFriendRequest(senderId, message)
+	}
+}
+
+// The packets handled the same way in every state of a logged-in player
+func (g *InGame) requests() userRequests {
+	return userRequests{client: g.client, player: g.player, userId: g.userId, logger: g.logger, game: g}
+}
+
+func (g *InGame) HandleLocal(message server.LocalMsg) {
+	switch message := message.(type) {
+	case *server.MoveRoom:
+		g.handleMoveRoom(message)
 	}
 }
 
@@ -72,21 +156,64 @@ func (g *InGame) handleDisconnect(senderId uint64, message *packets.Packet_Disco
 	go g.client.SocketSendAs(message, senderId)
 }
 
+func (g *InGame) handleTeamScoreboard(senderId uint64, message *packets.Packet_TeamScoreboard) {
+	g.client.SocketSendAs(message, senderId)
+}
+
+func (g *InGame) handleRoundState(senderId uint64, message *packets.Packet_RoundState) {
+	g.client.SocketSendAs(message, senderId)
+
+	// A new lobby means the world was reset, so start over with a fresh player. The lobby is announced on the room's
+	// goroutine, the client's own changes the state, once however often the lobby is announced until then.
+	if message.RoundState.Phase == packets.RoundPhase_ROUND_PHASE_LOBBY && message.RoundState.Round != g.round {
+		server.QueueTask(g.client, g, func() {
+			g.logger.Printf("Round %d is about to start, respawning", message.RoundState.Round)
+			g.endCause = endRoundOver
+			g.client.SetState(&InGame{
+				player: &objects.Player{
+					Name:   g.player.Name,
+					Color:  g.player.Color,
+					SkinId: g.player.SkinId,
+				},
+				userId: g.userId,
+			})
+		})
+	}
+}
+
+func (g *InGame) handleSafeZone(senderId uint64, message *packets.Packet_SafeZone) {
+	g.client.SocketSendAs(message, senderId)
+}
+
+func (g *InGame) handleWorldReset(senderId uint64, message *packets.Packet_WorldReset) {
+	g.client.SocketSendAs(message, senderId)
+}
+
+func (g *InGame) handlePowerUp(senderId uint64, message *packets.Packet_PowerUp) {
+	g.client.SocketSendAs(message, senderId)
+}
+
+func (g *InGame) handlePlayerEffects(senderId uint64, message *packets.Packet_PlayerEffects) {
+	g.client.SocketSendAs(message, senderId)
+}
+
 func (g *InGame) handleSpore(senderId uint64, message *packets.Packet_Spore) {
 	g.client.SocketSendAs(message, senderId)
 }
 
+func (g *InGame) handleSporesBatch(senderId uint64, message *packets.Packet_SporesBatch) {
+	if senderId != g.client.Id() {
+		g.client.SocketSendAs(message, senderId)
+	}
+}
+
 func (g *InGame) handlePlayerConsumed(senderId uint64, message *packets.Packet_PlayerConsumed) {
 	if senderId != g.client.Id() {
 		g.client.SocketSendAs(message, senderId)
 
+		// Other clients tell us on their goroutines, our own changes the state
 		if message.PlayerConsumed.PlayerId == g.client.Id() {
-			log.Println("Player was consumed, respawning")
-			g.client.SetState(&InGame{
-				player: &objects.Player{
-					Name: g.player.Name,
-				},
-			})
+			server.QueueTask(g.client, g, func() { g.eliminate(senderId) })
 		}
 
 		return
@@ -103,35 +230,122 @@ func (g *InGame) handlePlayerConsumed(senderId uint64, message *packets.Packet_P
 		return
 	}
 
+	// In battle royale mode players can only consume each other while a round is running
+	round := g.client.SharedGameObjects().Round
+	if round.Enabled() && !round.Running() {
+		g.logger.Println(errMsg + "the round is not running")
+		return
+	}
+
+	// Shielded players can't be consumed
+	if other.Effects.Active(objects.PowerUpShield) {
+		g.logger.Printf(errMsg+"player %d is shielded", otherId)
+		return
+	}
+
+	// Teammates can't consume each other
+	if g.player.Team != 0 && other.Team == g.player.Team {
+		g.logger.Printf(errMsg+"player %d is on the same team (%d)", otherId, g.player.Team)
+		return
+	}
+
 	// Next, check the other player's mass is smaller than our player's
 	ourMass := radToMass(g.player.Radius)
 	otherMass := radToMass(other.Radius)
 	if ourMass <= otherMass*1.5 {
-		g.logger.Printf(errMsg+"player not massive enough to consume the other player (our radius: %f, other radius: %f)", g.player.Radius, other.Radius)
+		reason := fmt.Sprintf("player not massive enough to consume the other player (our radius: %f, other radius: %f)", g.player.Radius, other.Radius)
+		g.logger.Println(errMsg + reason)
+		g.client.AntiCheat().Report(anticheat.ImplausibleConsumption, reason)
 		return
 	}
 
 	// Finally, check if the player is close enough to the other to be consumed
-	err = g.validatePlayerCloseToObject(other.X, other.Y, other.Radius, 10)
+	err = g.validatePlayerCloseToObject(other.X, other.Y, other.Radius, g.consumeBuffer())
 	if err != nil {
 		g.logger.Println(errMsg + err.Error())
+		g.client.AntiCheat().Report(anticheat.ImplausibleConsumption, err.Error())
+		return
+	}
+
+	// Shadow-flagged players aren't told their claims are being ignored
+	if g.client.AntiCheat().Flagged() {
+		g.logger.Printf("Ignoring consumption of player %d by flagged player", otherId)
 		return
 	}
 
 	// If we made it this far, the player consumption is valid, so grow the player, remove the consumed other, and broadcast the event
-	g.player.Radius = g.nextRadius(otherMass)
+	g.player.Radius = g.nextRadius(g.massGain(otherMass))
+	g.playersEaten++
+	g.largestKill = max(g.largestKill, otherMass)
+	g.killRatings = append(g.killRatings, peerRating(g.client, otherId))
+	g.updateRank()
+	g.checkAchievements()
 
 	go g.client.SharedGameObjects().Players.Remove(otherId)
 
 	g.client.Broadcast(message)
 }
 
-func (g *InGame) handleChat(senderId uint64, message *packets.Packet_Chat) {
-	if senderId == g.client.Id() {
-		g.client.Broadcast(message)
-	} else {
-		g.client.SocketSendAs(message, senderId)
+// Send the player to the death screen after they were consumed, killerId is 0 if they weren't consumed by another player
+func (g *InGame) eliminate(killerId uint64) {
+	var killerName string
+	if killer, exists := g.client.SharedGameObjects().Players.Get(killerId); exists {
+		killerName = killer.Name
+	}
+
+	g.logger.Printf("Player was consumed by %q (%d)", killerName, killerId)
+	g.endCause, g.killerName = endConsumed, killerName
+	if killerId == 0 {
+		g.endCause = endDrained
+	}
+	g.client.SetState(&Dead{
+		player: &objects.Player{
+			Name:   g.player.Name,
+			Color:  g.player.Color,
+			SkinId: g.player.SkinId,
+		},
+		userId: g.userId,
+		death: &packets.DeathMessage{
+			KillerId:   killerId,
+			KillerName: killerName,
+			FinalMass:  radToMass(g.player.Radius),
+			TimeAlive:  time.Since(g.spawnedAt).Seconds(),
+			Rank:       g.bestRank,
+		},
+	})
+}
+
+// Keep track of the best leaderboard position and the most mass the player reached during this life
+func (g *InGame) updateRank() {
+	g.peakMass = max(g.peakMass, radToMass(g.player.Radius))
+
+	rank := uint32(1)
+	g.client.SharedGameObjects().Players.ForEach(func(playerId uint64, player *objects.Player) {
+		if playerId != g.client.Id() && player.Radius > g.player.Radius {
+			rank++
+		}
+	})
+
+	if g.bestRank == 0 || rank < g.bestRank {
+		g.bestRank = rank
+	}
+}
+
+func (g *InGame) handleJoinFriendRequest(senderId uint64, message *packets.Packet_JoinFriendRequest) {
+	if !g.requests().fromSelf(senderId, message) {
+		return
 	}
+	joinFriend(g.client, g.userId, message, g.logger, func(friendName string, entry server.PresenceEntry, room *server.Room) {
+		if room == g.client.Room() {
+			g.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("You are playing in the same room as %s already", friendName)))
+			return
+		}
+		joinFriendRoom(g.client, g.player, g.userId, friendName, entry, room, g.logger)
+	})
+}
+
+func (g *InGame) handleMoveRoom(message *server.MoveRoom) {
+	followParty(g.client, g.player, g.userId, message, g.logger)
 }
 
 func (g *InGame) handlePlayerDirection(senderId uint64, message *packets.Packet_PlayerDirection) {
@@ -149,6 +363,7 @@ func (g *InGame) handlePlayerDirection(senderId uint64, message *packets.Packet_
 func (g *InGame) handlePlayerUpdate(senderId uint64, message *packets.Packet_Player) {
 	if senderId == g.client.Id() {
 		g.logger.Printf("Received player update from ourself")
+		g.client.AntiCheat().Report(anticheat.SelfPlayerUpdate, "client sent a player message for itself")
 		return
 	}
 	g.client.SocketSendAs(message, senderId)
@@ -158,34 +373,146 @@ func (g *InGame) updatePlayerLoop(ctx context.Context) {
 	const delta float64 = 0.05
 	ticker := time.NewTicker(time.Duration(delta*1000) * time.Millisecond)
 	defer ticker.Stop()
+	// Achievements for staying alive are reached without the player doing anything in particular. They're checked on
+	// the client's own goroutine, which keeps track of what the player ate.
+	achievementTicker := time.NewTicker(time.Second)
+	defer achievementTicker.Stop()
 
 	for {
 		select {
 		case <-ticker.C:
 			g.syncPlayer(delta)
+		case <-achievementTicker.C:
+			server.QueueTask(g.client, g, g.checkAchievements)
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
+// Move the player and let everyone know where it is
 func (g *InGame) syncPlayer(delta float64) {
-	newX := g.player.X + g.player.Speed*math.Cos(g.player.Direction)*delta
-	newY := g.player.Y + g.player.Speed*math.Sin(g.player.Direction)*delta
+	speed := g.player.Speed
+	if g.player.Effects.Active(objects.PowerUpSpeed) {
+		speed *= speedBoost
+	}
+
+	g.client.AntiCheat().ObserveDirection(g.player.Direction)
+
+	newX := g.player.X + speed*math.Cos(g.player.Direction)*delta
+	newY := g.player.Y + speed*math.Sin(g.player.Direction)*delta
 
 	g.player.X = newX
 	g.player.Y = newY
 
+	// Players outside the safe zone lose mass until there is nothing left of them. The client's own goroutine takes
+	// it off, it's the one which grows the player.
+	round := g.client.SharedGameObjects().Round
+	if !round.InZone(g.player.X, g.player.Y) {
+		drained := round.DrainRate() * delta
+		server.QueueTask(g.client, g, func() { g.drain(drained) })
+	}
+
+	// The magnet's spores are kept track of on the client's own goroutine, the one which eats them
+	server.QueueTask(g.client, g, func() {
+		if g.player.Effects.Active(objects.PowerUpMagnet) {
+			g.pullSpores(delta)
+		}
+		g.syncPulledSpores()
+	})
+
+	// Let everyone know when a power-up wore off
+	if g.player.Effects.Expire() {
+		g.broadcastEffects()
+	}
+
 	updatePacket := packets.NewPlayer(g.client.Id(), g.player)
 	g.client.Broadcast(updatePacket)
 	go g.client.SocketSend(updatePacket)
 }
 
+// Take mass off a player outside the safe zone, sending them to the death screen once there is too little left
+func (g *InGame) drain(mass float64) {
+	g.player.Radius = g.nextRadius(-mass)
+	if g.player.Radius < minRadius {
+		g.logger.Println("Player was drained by the safe zone")
+		g.client.Broadcast(packets.NewPlayerConsumed(g.client.Id()))
+		g.eliminate(0)
+	}
+}
+
+// Drag the spores around the player towards it
+func (g *InGame) pullSpores(delta float64) {
+	spores := g.client.SharedGameObjects().Spores
+	pulled := make(map[uint64]*objects.Spore)
+	spores.ForEach(func(sporeId uint64, spore *objects.Spore) {
+		dx := g.player.X - spore.X
+		dy := g.player.Y - spore.Y
+		dist := math.Hypot(dx, dy)
+		if dist == 0 || dist > g.player.Radius+magnetRange {
+			return
+		}
+
+		// Other clients read the spores without locking, so the moved spore replaces the old one instead of changing it
+		step := min(magnetPull*delta, dist)
+		pulled[sporeId] = &objects.Spore{
+			X:      spore.X + dx/dist*step,
+			Y:      spore.Y + dy/dist*step,
+			Radius: spore.Radius,
+		}
+	})
+
+	if g.pulledSpores == nil {
+		g.pulledSpores = make(map[uint64]struct{}, len(pulled))
+	}
+	for sporeId, spore := range pulled {
+		if spores.Replace(sporeId, spore) {
+			g.pulledSpores[sporeId] = struct{}{}
+		}
+	}
+}
+
+// Let everyone know where the magnet moved the spores to, at most once every magnetSyncInterval
+func (g *InGame) syncPulledSpores() {
+	if len(g.pulledSpores) == 0 || time.Since(g.pulledSporesAt) < magnetSyncInterval {
+		return
+	}
+
+	// Spores eaten in the meantime are left out, they would reappear otherwise
+	spores := g.client.SharedGameObjects().Spores
+	batch := make(map[uint64]*objects.Spore, len(g.pulledSpores))
+	for sporeId := range g.pulledSpores {
+		if spore, exists := spores.Get(sporeId); exists {
+			batch[sporeId] = spore
+		}
+	}
+	clear(g.pulledSpores)
+	g.pulledSporesAt = time.Now()
+	if len(batch) == 0 {
+		return
+	}
+
+	batchPacket := packets.NewSporesBatch(batch)
+	g.client.Broadcast(batchPacket)
+	g.client.SocketSend(batchPacket)
+}
+
+func (g *InGame) broadcastEffects() {
+	effectsPacket := packets.NewPlayerEffects(g.client.Id(), g.player.Effects.Remaining())
+	g.client.Broadcast(effectsPacket)
+	g.client.SocketSend(effectsPacket)
+}
+
 func (g *InGame) OnExit() {
 	if g.cancelPlayerUpdateLoop != nil {
 		g.cancelPlayerUpdateLoop()
 	}
+	// The player places among the others as they are when the life ends
+	outcome := g.ratingOutcome()
 	g.client.SharedGameObjects().Players.Remove(g.client.Id())
+	g.client.SharedGameObjects().Teams.Leave(g.client.Id())
+	g.recordSession()
+	g.recordRating(outcome)
 }
 
 func (g *InGame) sendInitialSpores(batchSize int, delay time.Duration) {
@@ -208,6 +535,18 @@ func (g *InGame) sendInitialSpores(batchSize int, delay time.Duration) {
 	}
 }
 
+func (g *InGame) sendInitialPowerUps() {
+	g.client.SharedGameObjects().PowerUps.ForEach(func(powerUpId uint64, powerUp *objects.PowerUp) {
+		g.client.SocketSend(packets.NewPowerUp(powerUpId, powerUp))
+	})
+}
+
+// How far off a consumption claim may be, allowing for the distance the player covers while the claim is in flight
+func (g *InGame) consumeBuffer() float64 {
+	const latencyAllowance = 0.1
+	return 10 + g.player.Speed*latencyAllowance
+}
+
 func (g *InGame) validatePlayerCloseToObject(objX, objY, objRadius, buffer float64) error {
 	realDX := g.player.X - objX
 	realDY := g.player.Y - objY
@@ -222,6 +561,24 @@ func (g *InGame) validatePlayerCloseToObject(objX, objY, objRadius, buffer float
 	return nil
 }
 
+// Players smaller than this are considered consumed
+const minRadius = 10.0
+
+// How far from a friend's edge players who joined them spawn, give or take half of it
+const friendSpawnDistance = 150.0
+
+const (
+	// Speed multiplier while the speed power-up is active
+	speedBoost = 1.5
+	// How far beyond its edge a player with the magnet power-up attracts spores, and how fast they move
+	magnetRange = 200.0
+	magnetPull  = 300.0
+	// How often the spores the magnet moved are sent, in one batch
+	magnetSyncInterval = 200 * time.Millisecond
+	// Mass multiplier while the mass multiplier power-up is active
+	massMultiplier = 2.0
+)
+
 func radToMass(radius float64) float64 {
 	return math.Pi * radius * radius
 }
@@ -232,10 +589,18 @@ func massToRad(mass float64) float64 {
 
 func (g *InGame) nextRadius(massDiff float64) float64 {
 	oldMass := radToMass(g.player.Radius)
-	newMass := oldMass + massDiff
+	newMass := max(oldMass+massDiff, 0)
 	return massToRad(newMass)
 }
 
+// The mass the player gains from consuming something, taking power-ups into account
+func (g *InGame) massGain(mass float64) float64 {
+	if g.player.Effects.Active(objects.PowerUpMassMultiplier) {
+		return mass * massMultiplier
+	}
+	return mass
+}
+
 func (g *InGame) getOtherPlayer(otherId uint64) (*objects.Player, error) {
 	other, exists := g.client.SharedGameObjects().Players.Get(otherId)
 	if !exists {
@@ -270,17 +635,65 @@ func (g *InGame) handleSporeConsumed(senderId uint64, message *packets.Packet_Sp
 	}
 
 	// Next, check if the spore is close enough to the player to be consumed
-	err = g.validatePlayerCloseToObject(spore.X, spore.Y, spore.Radius, 10)
+	err = g.validatePlayerCloseToObject(spore.X, spore.Y, spore.Radius, g.consumeBuffer())
 	if err != nil {
 		g.logger.Println(errMsg + err.Error())
+		g.client.AntiCheat().Report(anticheat.ImplausibleConsumption, err.Error())
+		return
+	}
+
+	// Shadow-flagged players aren't told their claims are being ignored
+	if g.client.AntiCheat().Flagged() {
+		g.logger.Printf("Ignoring consumption of spore %d by flagged player", sporeId)
 		return
 	}
 
 	// If we made it this far, the spore consumption is valid, so grow the player, remove the spore, and broadcast the event
 	sporeMass := radToMass(spore.Radius)
-	g.player.Radius = g.nextRadius(sporeMass)
+	g.player.Radius = g.nextRadius(g.massGain(sporeMass))
+	g.sporesEaten++
+	g.updateRank()
+	g.checkAchievements()
 
 	go g.client.SharedGameObjects().Spores.Remove(sporeId)
 
 	g.client.Broadcast(message)
 }
+
+func (g *InGame) handlePowerUpConsumed(senderId uint64, message *packets.Packet_PowerUpConsumed) {
+	if senderId != g.client.Id() {
+		g.client.SocketSendAs(message, senderId)
+		return
+	}
+
+	// If the power-up was supposedly picked up by our own player, we need to verify the plausibility of the event
+	errMsg := "Could not verify power-up consumption: "
+
+	powerUpId := message.PowerUpConsumed.PowerUpId
+	powerUp, exists := g.client.SharedGameObjects().PowerUps.Get(powerUpId)
+	if !exists {
+		g.logger.Printf(errMsg+"power-up with id %d does not exist", powerUpId)
+		return
+	}
+
+	err := g.validatePlayerCloseToObject(powerUp.X, powerUp.Y, powerUp.Radius, g.consumeBuffer())
+	if err != nil {
+		g.logger.Println(errMsg + err.Error())
+		g.client.AntiCheat().Report(anticheat.ImplausibleConsumption, err.Error())
+		return
+	}
+
+	// Shadow-flagged players aren't told their claims are being ignored
+	if g.client.AntiCheat().Flagged() {
+		g.logger.Printf("Ignoring pickup of power-up %d by flagged player", powerUpId)
+		return
+	}
+
+	// If we made it this far, the pickup is valid, so activate the effect, remove the power-up, and broadcast the event
+	g.player.Effects.Add(powerUp.Kind, powerUp.Duration)
+
+	go g.client.SharedGameObjects().PowerUps.Remove(powerUpId)
+
+	g.client.Broadcast(message)
+	g.broadcastEffects()
+}
@@ -0,0 +1,122 @@
+package states
+
+import (
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+)
+
+// Handle a player's party request, the same way whether they're playing, dead or spectating
+func partyRequest(client server.ClientInterfacer, player *objects.Player, message packets.Msg, logger *log.Logger) {
+	parties := client.Parties()
+	self := server.PartyMember{ClientId: client.Id(), Name: player.Name}
+
+	switch message := message.(type) {
+	case *packets.Packet_PartyCreateRequest:
+		party, err := parties.Create(self, client.Room().Name)
+		if err != nil {
+			denyPartyRequest(client, "create a party", err)
+			return
+		}
+		logger.Printf("Created party %d", party.Id)
+		server.SendParty(client, party)
+
+	case *packets.Packet_PartyInviteRequest:
+		inviteeId, exists := client.Names().Owner(message.PartyInviteRequest.Name)
+		invitee, connected := client.Peer(inviteeId)
+		if !exists || !connected || inviteeId == client.Id() {
+			client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("There is no player called %s", message.PartyInviteRequest.Name)))
+			return
+		}
+
+		party, err := parties.Invite(client.Id(), inviteeId)
+		if err != nil {
+			denyPartyRequest(client, "invite them", err)
+			return
+		}
+		logger.Printf("Invited client %d to party %d", inviteeId, party.Id)
+		invitee.SocketSend(packets.NewPartyInvite(party.Id, player.Name))
+		client.SocketSend(packets.NewOkResponse())
+
+	case *packets.Packet_PartyAcceptRequest:
+		party, err := parties.Accept(self, message.PartyAcceptRequest.PartyId)
+		if err != nil {
+			denyPartyRequest(client, "join the party", err)
+			return
+		}
+		logger.Printf("Joined party %d", party.Id)
+		server.SendParty(client, party)
+		if party.Room != client.Room().Name {
+			client.Queue(&server.MoveRoom{Room: party.Room})
+		}
+
+	case *packets.Packet_PartyLeaveRequest:
+		party, err := parties.Leave(client.Id())
+		if err != nil {
+			denyPartyRequest(client, "leave the party", err)
+			return
+		}
+		logger.Printf("Left party %d", party.Id)
+		client.SocketSend(server.NewPartyMessage(server.Party{}))
+		server.SendParty(client, party)
+
+	case *packets.Packet_PartyKickRequest:
+		memberId := message.PartyKickRequest.MemberId
+		party, err := parties.Kick(client.Id(), memberId)
+		if err != nil {
+			denyPartyRequest(client, "kick them", err)
+			return
+		}
+		logger.Printf("Kicked client %d out of party %d", memberId, party.Id)
+		if member, exists := client.Peer(memberId); exists {
+			member.SocketSend(server.NewPartyMessage(server.Party{}))
+		}
+		server.SendParty(client, party)
+
+	case *packets.Packet_PartyRoomRequest:
+		if err := checkPartyFits(client, message.PartyRoomRequest.Room); err != nil {
+			denyPartyRequest(client, "choose the room", err)
+			return
+		}
+		party, err := parties.SetRoom(client.Id(), message.PartyRoomRequest.Room)
+		if err != nil {
+			denyPartyRequest(client, "choose the room", err)
+			return
+		}
+		logger.Printf("Party %d is playing in %s now", party.Id, party.Room)
+		server.SendParty(client, party)
+
+		// Everyone, the leader included, follows the party into its room, each on their own client's goroutine
+		for _, member := range party.Members {
+			if peer, exists := client.Peer(member.ClientId); exists && peer.Room().Name != party.Room {
+				peer.Queue(&server.MoveRoom{Room: party.Room})
+			}
+		}
+	}
+}
+
+// Check there is space in the room for the members of the client's party who aren't in it yet
+func checkPartyFits(client server.ClientInterfacer, roomName string) error {
+	room, exists := client.Rooms().Get(roomName)
+	if !exists {
+		return server.ErrUnknownRoom
+	}
+	party, _ := client.Parties().Get(client.Id())
+
+	moving := 0
+	for _, member := range party.Members {
+		if peer, exists := client.Peer(member.ClientId); exists && peer.Room() != room {
+			moving++
+		}
+	}
+	if !room.Fits(moving) {
+		return server.ErrRoomFull
+	}
+	return nil
+}
+
+func denyPartyRequest(client server.ClientInterfacer, action string, err error) {
+	client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Can't %s: %v", action, err)))
+}
@@ -0,0 +1,118 @@
+package states
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/internal/server/names"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+)
+
+// The most bytes of client settings stored per user
+const maxProfileSettings = 1024
+
+var errDisplayNameTaken = errors.New("that display name belongs to another user")
+
+// Get a user's profile, users who never saved one get the default profile
+func loadProfile(ctx context.Context, client server.ClientInterfacer, userId int64) (db.UserProfile, error) {
+	profile, err := client.DbTx().Users.GetUserProfile(ctx, userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.UserProfile{UserID: userId}, nil
+	}
+	return profile, err
+}
+
+func sendProfile(client server.ClientInterfacer, userId int64, logger *log.Logger) {
+	if userId == 0 {
+		client.SocketSend(packets.NewDenyResponse("Guests don't have a profile, register to keep your preferences"))
+		return
+	}
+
+	var profile db.UserProfile
+	runUserJob(client, "get profile", logger, func(ctx context.Context) error {
+		var err error
+		profile, err = loadProfile(ctx, client, userId)
+		return err
+	}, func() {
+		client.SocketSend(packets.NewProfile(int32(profile.Color), profile.DisplayName, uint32(profile.SkinID), profile.Settings))
+	})
+}
+
+// Save the profile our own player sent, the colour and skin apply straight away and the display name from the next login
+func updateProfile(client server.ClientInterfacer, player *objects.Player, userId int64, message *packets.Packet_Profile, logger *log.Logger) {
+	if userId == 0 {
+		client.SocketSend(packets.NewDenyResponse("Guests don't have a profile, register to keep your preferences"))
+		return
+	}
+
+	update := message.Profile
+	if len(update.Settings) > maxProfileSettings {
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Settings can be at most %d bytes", maxProfileSettings)))
+		return
+	}
+
+	if update.DisplayName != "" {
+		if err := validateUsername(update.DisplayName); err != nil {
+			client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Invalid display name: %v", err)))
+			return
+		}
+	}
+
+	// The column can't be NULL, which is what a nil slice is stored as
+	settings := update.Settings
+	if settings == nil {
+		settings = []byte{}
+	}
+
+	var profile db.UserProfile
+	runUserJob(client, "save profile", logger, func(ctx context.Context) error {
+		users := client.DbTx().Users
+
+		// Players can't pass themselves off as another registered user, under their username or their display name
+		if update.DisplayName != "" {
+			displayName := names.Normalize(update.DisplayName)
+			owner, err := users.GetUserByUsername(ctx, displayName)
+			if err == nil && owner.ID != userId {
+				return errDisplayNameTaken
+			}
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+
+			_, err = users.GetUserProfileByDisplayName(ctx, db.GetUserProfileByDisplayNameParams{
+				DisplayName: displayName,
+				UserID:      userId,
+			})
+			if err == nil {
+				return errDisplayNameTaken
+			}
+			if !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+		}
+
+		var err error
+		profile, err = users.UpsertUserProfile(ctx, db.UpsertUserProfileParams{
+			UserID:      userId,
+			Color:       int64(update.Color),
+			DisplayName: update.DisplayName,
+			SkinID:      int64(update.SkinId),
+			Settings:    settings,
+		})
+		return err
+	}, func() {
+		// The team colour takes precedence in team mode
+		if player.Team == 0 && profile.Color != 0 {
+			player.Color = int32(profile.Color)
+		}
+		player.SkinId = uint32(profile.SkinID)
+
+		client.SocketSend(packets.NewOkResponse())
+		client.SocketSend(packets.NewProfile(int32(profile.Color), profile.DisplayName, uint32(profile.SkinID), profile.Settings))
+	})
+}
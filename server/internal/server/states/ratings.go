@@ -0,0 +1,194 @@
+package states
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"math"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/internal/server/names"
+	"server/internal/server/objects"
+	"server/internal/server/rating"
+	"server/internal/server/storage"
+	"server/pkg/packets"
+)
+
+// How many rating changes a profile shows
+const profileHistoryLimit = 10
+
+// How many users a leaderboard request gets when it doesn't ask for a number, and the most it can ask for
+const (
+	defaultLeaderboardLimit = 10
+	maxLeaderboardLimit     = 100
+)
+
+// The rating of the player of another client, players who left already count as new ones
+func peerRating(client server.ClientInterfacer, peerId uint64) float64 {
+	if peer, exists := client.Peer(peerId); exists {
+		return peer.Rating()
+	}
+	return rating.Initial
+}
+
+// How the life went as far as the rating is concerned, at the moment it ends: where the player places by size among
+// the players in the room right now, how the others are rated and who the player ate
+func (g *InGame) ratingOutcome() rating.Outcome {
+	larger, tied := 0, 0
+	var others []uint64
+	g.client.SharedGameObjects().Players.ForEach(func(playerId uint64, player *objects.Player) {
+		if playerId == g.client.Id() {
+			return
+		}
+		others = append(others, playerId)
+		switch {
+		case player.Radius > g.player.Radius:
+			larger++
+		case player.Radius == g.player.Radius:
+			tied++
+		}
+	})
+
+	outcome := rating.Outcome{
+		Placement: 1 + float64(larger) + float64(tied)/2,
+		Players:   len(others) + 1,
+		Field:     rating.Initial,
+		Kills:     g.killRatings,
+	}
+	if len(others) > 0 {
+		total := 0.0
+		for _, playerId := range others {
+			total += peerRating(g.client, playerId)
+		}
+		outcome.Field = total / float64(len(others))
+	}
+	return outcome
+}
+
+// Update a registered user's rating now that this life is over, and keep the change in their history. Like the
+// session it's written by a worker, and the new rating is what the user is matched by from then on.
+func (g *InGame) recordRating(outcome rating.Outcome) {
+	if g.userId == 0 || g.client.AntiCheat().Flagged() {
+		return
+	}
+
+	// Nothing happened which could say anything about how good the player is
+	if outcome.Players <= 1 && len(outcome.Kills) == 0 {
+		return
+	}
+
+	client, userId, logger := g.client, g.userId, g.logger
+	err := client.Workers().Submit(client, func(ctx context.Context) error {
+		var updated float64
+		err := client.DbTx().Users.WithTx(ctx, func(users storage.Users) error {
+			current, err := loadRating(ctx, users, userId)
+			if err != nil {
+				return err
+			}
+
+			updated = rating.Update(current, outcome)
+			if err := users.UpsertUserRating(ctx, db.UpsertUserRatingParams{UserID: userId, Rating: updated}); err != nil {
+				return err
+			}
+			return users.CreateRatingChange(ctx, db.CreateRatingChangeParams{
+				UserID:    userId,
+				Rating:    updated,
+				Delta:     updated - current,
+				Placement: int64(math.Round(outcome.Placement)),
+				Players:   int64(outcome.Players),
+				Kills:     int64(len(outcome.Kills)),
+			})
+		})
+		if err != nil {
+			logger.Printf("Failed to update the rating of user %d: %v", userId, err)
+			return nil
+		}
+
+		client.SetRating(updated)
+		return nil
+	}, nil)
+	if err != nil {
+		g.logger.Printf("Failed to update the rating of user %d: %v", userId, err)
+	}
+}
+
+// Send the rating, rank and recent rating changes of a user, or of our own user if no username is given
+func sendPlayerProfile(client server.ClientInterfacer, userId int64, message *packets.Packet_PlayerProfileRequest, logger *log.Logger) {
+	username := message.PlayerProfileRequest.Username
+	if username == "" && userId == 0 {
+		client.SocketSend(packets.NewDenyResponse("Guests don't have a rating, register to get one"))
+		return
+	}
+
+	var user db.User
+	var userRating float64
+	var above int64
+	var history []db.RatingHistory
+	runUserJob(client, "get profile", logger, func(ctx context.Context) error {
+		users := client.DbTx().Users
+		var err error
+		if username == "" {
+			user, err = users.GetUserByID(ctx, userId)
+		} else {
+			user, err = users.GetUserByUsername(ctx, names.Normalize(username))
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			return errNoSuchUser
+		}
+		if err != nil {
+			return err
+		}
+
+		if userRating, err = loadRating(ctx, users, user.ID); err != nil {
+			return err
+		}
+		if above, err = users.CountUsersRatedAbove(ctx, userRating); err != nil {
+			return err
+		}
+		history, err = users.ListRatingHistoryByUser(ctx, db.ListRatingHistoryByUserParams{
+			UserID: user.ID,
+			Limit:  profileHistoryLimit,
+		})
+		return err
+	}, func() {
+		changes := make([]*packets.RatingChangeMessage, len(history))
+		for i, change := range history {
+			changes[i] = &packets.RatingChangeMessage{
+				Rating:    change.Rating,
+				Delta:     change.Delta,
+				Placement: uint32(change.Placement),
+				Players:   uint32(change.Players),
+				Kills:     uint32(change.Kills),
+				ChangedAt: change.CreatedAt.Unix(),
+			}
+		}
+		client.SocketSend(packets.NewPlayerProfile(user.Username, userRating, uint32(above+1), changes))
+	})
+}
+
+// Send the highest rated users
+func sendLeaderboard(client server.ClientInterfacer, message *packets.Packet_LeaderboardRequest, logger *log.Logger) {
+	limit := int64(message.LeaderboardRequest.Limit)
+	if limit == 0 {
+		limit = defaultLeaderboardLimit
+	}
+	limit = min(limit, maxLeaderboardLimit)
+
+	var top []db.ListTopRatingsRow
+	runUserJob(client, "get leaderboard", logger, func(ctx context.Context) error {
+		var err error
+		top, err = client.DbTx().Users.ListTopRatings(ctx, limit)
+		return err
+	}, func() {
+		entries := make([]*packets.LeaderboardEntryMessage, len(top))
+		for i, entry := range top {
+			entries[i] = &packets.LeaderboardEntryMessage{
+				Rank:     uint32(i + 1),
+				Username: entry.Username,
+				Rating:   entry.Rating,
+			}
+		}
+		client.SocketSend(packets.NewLeaderboard(entries))
+	})
+}
@@ -0,0 +1,73 @@
+package states
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/matchmaking"
+	"server/internal/server/objects"
+	"server/internal/server/rating"
+	"server/internal/server/storage"
+	"server/pkg/packets"
+)
+
+// The rating a user is matched by, users who never played a rated game have the default one
+func loadRating(ctx context.Context, users storage.Users, userId int64) (float64, error) {
+	stored, err := users.GetUserRating(ctx, userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return rating.Initial, nil
+	}
+	return stored.Rating, err
+}
+
+// Start playing in the room the matchmaker picks for a player who just logged in, in the region they asked for if
+// there is one
+func (c *Connected) play(player *objects.Player, userId int64, userRating float64, region string) {
+	c.client.SetRating(userRating)
+	room, err := c.client.Rooms().Place(matchmaking.Player{Region: region, Rating: userRating, Size: 1})
+	if err != nil {
+		c.logger.Printf("Failed to find a room for %s: %v", player.Name, err)
+		c.client.Names().Release(c.client.Id())
+		c.client.SocketSend(packets.NewDenyResponse("All rooms are full - please try again later"))
+		return
+	}
+
+	c.logger.Printf("Placing %s in room %s", player.Name, room.Name)
+	c.client.SocketSend(packets.NewOkResponse())
+	c.client.JoinRoom(room, playState(room, player, userId))
+}
+
+// Move the player into another room, where they start over next to the player of the given client, or anywhere if
+// it's 0. They spectate if a round is running there.
+func moveToRoom(client server.ClientInterfacer, room *server.Room, player *objects.Player, userId int64, near uint64) {
+	fresh := &objects.Player{
+		Name:   player.Name,
+		Color:  player.Color,
+		SkinId: player.SkinId,
+	}
+
+	var state server.ClientStateHandler = &InGame{player: fresh, userId: userId, near: near}
+	if room.SharedGameObjects.Round.Running() {
+		state = &Spectating{player: fresh, userId: userId}
+	}
+	client.JoinRoom(room, state)
+}
+
+// Follow the party into the room its leader chose, returns whether the player moved
+func followParty(client server.ClientInterfacer, player *objects.Player, userId int64, message *server.MoveRoom, logger *log.Logger) bool {
+	room, exists := client.Rooms().Get(message.Room)
+	if !exists || room == client.Room() {
+		return false
+	}
+	if !room.Fits(1) {
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Can't follow your party into %s: %v", room.Name, server.ErrRoomFull)))
+		return false
+	}
+
+	logger.Printf("Following the party into room %s", room.Name)
+	moveToRoom(client, room, player, userId, 0)
+	return true
+}
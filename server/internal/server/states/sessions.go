@@ -0,0 +1,100 @@
+package states
+
+import (
+	"context"
+	"database/sql"
+	"server/internal/server/db"
+	"server/pkg/packets"
+	"time"
+)
+
+// Why a life in the game ended
+const (
+	endConsumed  = "consumed"
+	endDrained   = "drained"
+	endRoundOver = "round over"
+	endLeft      = "left"
+)
+
+// How many sessions a history request gets when it doesn't ask for a number, and the most it can ask for
+const (
+	defaultHistoryLimit = 10
+	maxHistoryLimit     = 50
+)
+
+// Store how this life went. It's written by a worker so leaving the game doesn't wait for the database.
+func (g *InGame) recordSession() {
+	endCause := g.endCause
+	if endCause == "" {
+		endCause = endLeft
+	}
+
+	params := db.CreateSessionParams{
+		UserID:       sql.NullInt64{Int64: g.userId, Valid: g.userId != 0},
+		PlayerName:   g.player.Name,
+		Room:         g.client.Room().Name,
+		StartedAt:    g.spawnedAt.UTC(),
+		EndedAt:      time.Now().UTC(),
+		EndCause:     endCause,
+		KillerName:   g.killerName,
+		PeakMass:     g.peakMass,
+		SporesEaten:  g.sporesEaten,
+		PlayersEaten: g.playersEaten,
+	}
+
+	users, logger := g.client.DbTx().Users, g.logger
+	err := g.client.Workers().Submit(g.client, func(ctx context.Context) error {
+		if err := users.CreateSession(ctx, params); err != nil {
+			logger.Printf("Failed to record the session of %s: %v", params.PlayerName, err)
+		}
+		return nil
+	}, nil)
+	if err != nil {
+		g.logger.Printf("Failed to record the session of %s: %v", params.PlayerName, err)
+	}
+}
+
+// Send a user the last games they played, they don't need to be logged in but have to give their password
+func (c *Connected) handleHistoryRequest(senderId uint64, message *packets.Packet_HistoryRequest) {
+	if senderId != c.client.Id() {
+		c.logger.Printf("Received history request from %d, but I'm %d", senderId, c.client.Id())
+		return
+	}
+
+	request := message.HistoryRequest
+	limit := int64(request.Limit)
+	if limit == 0 {
+		limit = defaultHistoryLimit
+	}
+	limit = min(limit, maxHistoryLimit)
+
+	var sessions []db.Session
+	c.runJob("get history", request.Username, func(ctx context.Context) error {
+		user, err := c.checkPassword(ctx, request.Username, request.Password)
+		if err != nil {
+			return err
+		}
+
+		sessions, err = c.users.ListRecentSessionsByUser(ctx, db.ListRecentSessionsByUserParams{
+			UserID: sql.NullInt64{Int64: user.ID, Valid: true},
+			Limit:  limit,
+		})
+		return err
+	}, func() {
+		messages := make([]*packets.SessionMessage, len(sessions))
+		for i, session := range sessions {
+			messages[i] = &packets.SessionMessage{
+				PlayerName:   session.PlayerName,
+				Room:         session.Room,
+				StartedAt:    session.StartedAt.UnixMilli(),
+				EndedAt:      session.EndedAt.UnixMilli(),
+				EndCause:     session.EndCause,
+				KillerName:   session.KillerName,
+				PeakMass:     session.PeakMass,
+				SporesEaten:  uint32(session.SporesEaten),
+				PlayersEaten: uint32(session.PlayersEaten),
+			}
+		}
+		c.client.SocketSend(packets.NewHistory(messages))
+	})
+}
@@ -0,0 +1,141 @@
+package states
+
+import (
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+)
+
+// Players who joined while a battle royale round was running watch the game in this state until the next lobby opens
+type Spectating struct {
+	client server.ClientInterfacer
+	player *objects.Player
+	userId int64
+	logger *log.Logger
+}
+
+// The state a player joining the game in the room should enter, during a running round they have to wait for the
+// next one
+func playState(room *server.Room, player *objects.Player, userId int64) server.ClientStateHandler {
+	if room.SharedGameObjects.Round.Running() {
+		return &Spectating{player: player, userId: userId}
+	}
+	return &InGame{player: player, userId: userId, joining: true}
+}
+
+// Pass on what's happening in the world to a client who isn't playing, returns false if the message isn't a world update
+func forwardWorldUpdate(client server.ClientInterfacer, senderId uint64, message packets.Msg) bool {
+	switch message.(type) {
+	case *packets.Packet_Player, *packets.Packet_Spore, *packets.Packet_SporesBatch, *packets.Packet_SporeConsumed,
+		*packets.Packet_PlayerConsumed, *packets.Packet_SafeZone, *packets.Packet_TeamScoreboard,
+		*packets.Packet_PowerUp, *packets.Packet_PowerUpConsumed, *packets.Packet_PlayerEffects, *packets.Packet_WorldReset:
+		if senderId != client.Id() {
+			client.SocketSendAs(message, senderId)
+		}
+		return true
+	}
+	return false
+}
+
+func (s *Spectating) Name() string {
+	return "Spectating"
+}
+
+func (s *Spectating) UserId() int64 {
+	return s.userId
+}
+
+func (s *Spectating) SetClient(client server.ClientInterfacer) {
+	s.client = client
+	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), s.Name())
+	s.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
+}
+
+func (s *Spectating) OnEnter() {
+	round := s.client.SharedGameObjects().Round
+	s.logger.Printf("Spectating until round %d is over", round.Number())
+	s.client.SocketSend(packets.NewRoundState(round.Number(), round.Phase(), round.TimeRemaining().Seconds(), 0, ""))
+
+	// Spectators always just joined the game, so they need to catch up on the chat
+	sendChatHistory(s.client)
+}
+
+func (s *Spectating) HandleMessage(senderId uint64, message packets.Msg) {
+	if s.requests().handle(senderId, message) {
+		return
+	}
+
+	switch message := message.(type) {
+	case *packets.Packet_RoundState:
+		s.handleRoundState(senderId, message)
+	case *packets.Packet_Disconnect:
+		s.handleDisconnect(senderId, message)
+	case *packets.Packet_JoinFriendRequest:
+		s.handleJoinFriendRequest(senderId, message)
+	default:
+		// Spectators only get to watch what the players are doing
+		forwardWorldUpdate(s.client, senderId, message)
+	}
+}
+
+// The packets handled the same way in every state of a logged-in player
+func (s *Spectating) requests() userRequests {
+	return userRequests{client: s.client, player: s.player, userId: s.userId, logger: s.logger}
+}
+
+func (s *Spectating) HandleLocal(message server.LocalMsg) {
+	switch message := message.(type) {
+	case *server.MoveRoom:
+		s.handleMoveRoom(message)
+	}
+}
+
+func (s *Spectating) handleRoundState(senderId uint64, message *packets.Packet_RoundState) {
+	s.client.SocketSendAs(message, senderId)
+
+	// The room's goroutine announces the lobby, the client's own starts playing
+	if message.RoundState.Phase == packets.RoundPhase_ROUND_PHASE_LOBBY {
+		server.QueueTask(s.client, s, func() {
+			s.client.SetState(&InGame{
+				player: &objects.Player{
+					Name:   s.player.Name,
+					Color:  s.player.Color,
+					SkinId: s.player.SkinId,
+				},
+				userId: s.userId,
+			})
+		})
+	}
+}
+
+func (s *Spectating) handleJoinFriendRequest(senderId uint64, message *packets.Packet_JoinFriendRequest) {
+	if !s.requests().fromSelf(senderId, message) {
+		return
+	}
+	joinFriend(s.client, s.userId, message, s.logger, func(friendName string, entry server.PresenceEntry, room *server.Room) {
+		if room == s.client.Room() {
+			s.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("You are in the same room as %s already, you can play once the next round starts", friendName)))
+			return
+		}
+		joinFriendRoom(s.client, s.player, s.userId, friendName, entry, room, s.logger)
+	})
+}
+
+func (s *Spectating) handleMoveRoom(message *server.MoveRoom) {
+	followParty(s.client, s.player, s.userId, message, s.logger)
+}
+
+func (s *Spectating) handleDisconnect(senderId uint64, message *packets.Packet_Disconnect) {
+	if senderId == s.client.Id() {
+		s.client.Broadcast(message)
+		s.client.SetState(&Connected{})
+		return
+	}
+
+	s.client.SocketSendAs(message, senderId)
+}
+
+func (s *Spectating) OnExit() {
+}
@@ -0,0 +1,90 @@
+package states
+
+import (
+	"log"
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"strings"
+)
+
+// Handles the packets a logged-in player's state treats the same way whether they're playing, dead or spectating:
+// chat, reports, profiles, ratings, friends and parties
+type userRequests struct {
+	client server.ClientInterfacer
+	player *objects.Player
+	userId int64
+	logger *log.Logger
+	// The player's state if they're playing, nil otherwise
+	game *InGame
+}
+
+// Handle the message if it's one of the shared ones, returns false if the state has to handle it itself
+func (u userRequests) handle(senderId uint64, message packets.Msg) bool {
+	switch message := message.(type) {
+	case *packets.Packet_Chat:
+		u.handleChat(senderId, message)
+	case *packets.Packet_ReportMessage:
+		if u.fromSelf(senderId, message) {
+			reportMessage(u.client, u.player, u.userId, message, u.logger)
+		}
+	case *packets.Packet_Profile:
+		if u.fromSelf(senderId, message) {
+			updateProfile(u.client, u.player, u.userId, message, u.logger)
+		}
+	case *packets.Packet_GetProfileRequest:
+		if u.fromSelf(senderId, message) {
+			sendProfile(u.client, u.userId, u.logger)
+		}
+	case *packets.Packet_PlayerProfileRequest:
+		if u.fromSelf(senderId, message) {
+			sendPlayerProfile(u.client, u.userId, message, u.logger)
+		}
+	case *packets.Packet_LeaderboardRequest:
+		if u.fromSelf(senderId, message) {
+			sendLeaderboard(u.client, message, u.logger)
+		}
+	case *packets.Packet_AddFriendRequest:
+		if u.fromSelf(senderId, message) {
+			addFriend(u.client, u.userId, message, u.logger)
+		}
+	case *packets.Packet_RemoveFriendRequest:
+		if u.fromSelf(senderId, message) {
+			removeFriend(u.client, u.userId, message, u.logger)
+		}
+	case *packets.Packet_FriendListRequest:
+		if u.fromSelf(senderId, message) {
+			sendFriendList(u.client, u.userId, u.logger)
+		}
+	case *packets.Packet_PartyCreateRequest, *packets.Packet_PartyInviteRequest, *packets.Packet_PartyAcceptRequest,
+		*packets.Packet_PartyLeaveRequest, *packets.Packet_PartyKickRequest, *packets.Packet_PartyRoomRequest:
+		if u.fromSelf(senderId, message) {
+			partyRequest(u.client, u.player, message, u.logger)
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// Whether our own player sent the message, requests from anyone else are ignored
+func (u userRequests) fromSelf(senderId uint64, message packets.Msg) bool {
+	if senderId != u.client.Id() {
+		u.logger.Printf("Received %T from %d, but I'm %d", message, senderId, u.client.Id())
+		return false
+	}
+	return true
+}
+
+func (u userRequests) handleChat(senderId uint64, message *packets.Packet_Chat) {
+	if senderId != u.client.Id() {
+		receiveChat(u.client, u.player, senderId, message)
+		return
+	}
+
+	if strings.HasPrefix(message.Chat.Msg, "/") {
+		(&commander{client: u.client, player: u.player, userId: u.userId, logger: u.logger, game: u.game}).handleCommand(message.Chat.Msg)
+		return
+	}
+	sendChat(u.client, u.player, message, u.logger)
+}
@@ -0,0 +1,291 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"server/internal/server/db"
+	"server/internal/server/db/postgres"
+
+	"github.com/lib/pq"
+)
+
+// The code PostgreSQL fails with when a unique constraint is violated
+const pgUniqueViolation = "23505"
+
+// User accounts kept in PostgreSQL
+type Postgres struct {
+	queries *postgres.Queries
+	// nil inside a transaction
+	dbPool *sql.DB
+}
+
+// Use a PostgreSQL database whose tables were already created
+func NewPostgres(dbPool *sql.DB) *Postgres {
+	return &Postgres{
+		queries: postgres.New(dbPool),
+		dbPool:  dbPool,
+	}
+}
+
+func (p *Postgres) WithTx(ctx context.Context, fn func(users Users) error) error {
+	// Transactions don't nest, fn becomes part of the one we're in
+	if p.dbPool == nil {
+		return fn(p)
+	}
+
+	return RunInTx(ctx, p.dbPool, func(tx *sql.Tx) error {
+		return fn(&Postgres{queries: p.queries.WithTx(tx)})
+	})
+}
+
+func (p *Postgres) GetUserByID(ctx context.Context, id int64) (db.User, error) {
+	user, err := p.queries.GetUserByID(ctx, id)
+	return db.User(user), err
+}
+
+func (p *Postgres) GetUserByUsername(ctx context.Context, username string) (db.User, error) {
+	user, err := p.queries.GetUserByUsername(ctx, username)
+	return db.User(user), err
+}
+
+func (p *Postgres) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+	user, err := p.queries.CreateUser(ctx, postgres.CreateUserParams(arg))
+	if pqErr := (*pq.Error)(nil); errors.As(err, &pqErr) && pqErr.Code == pgUniqueViolation {
+		return db.User{}, ErrUserExists
+	}
+	return db.User(user), err
+}
+
+func (p *Postgres) UpdateUserPassword(ctx context.Context, arg db.UpdateUserPasswordParams) error {
+	return p.queries.UpdateUserPassword(ctx, postgres.UpdateUserPasswordParams(arg))
+}
+
+func (p *Postgres) SetUserRole(ctx context.Context, arg db.SetUserRoleParams) (int64, error) {
+	return p.queries.SetUserRole(ctx, postgres.SetUserRoleParams(arg))
+}
+
+func (p *Postgres) DeleteUser(ctx context.Context, id int64) error {
+	return p.queries.DeleteUser(ctx, id)
+}
+
+func (p *Postgres) GetUserProfile(ctx context.Context, userID int64) (db.UserProfile, error) {
+	profile, err := p.queries.GetUserProfile(ctx, userID)
+	return fromPostgresProfile(profile), err
+}
+
+func (p *Postgres) GetUserProfileByDisplayName(ctx context.Context, arg db.GetUserProfileByDisplayNameParams) (db.UserProfile, error) {
+	profile, err := p.queries.GetUserProfileByDisplayName(ctx, postgres.GetUserProfileByDisplayNameParams(arg))
+	return fromPostgresProfile(profile), err
+}
+
+func (p *Postgres) UpsertUserProfile(ctx context.Context, arg db.UpsertUserProfileParams) (db.UserProfile, error) {
+	profile, err := p.queries.UpsertUserProfile(ctx, postgres.UpsertUserProfileParams{
+		UserID:      arg.UserID,
+		Color:       int32(arg.Color),
+		DisplayName: arg.DisplayName,
+		SkinID:      int32(arg.SkinID),
+		Settings:    arg.Settings,
+	})
+	return fromPostgresProfile(profile), err
+}
+
+func (p *Postgres) DeleteUserProfile(ctx context.Context, userID int64) error {
+	return p.queries.DeleteUserProfile(ctx, userID)
+}
+
+func (p *Postgres) CreatePasswordReset(ctx context.Context, arg db.CreatePasswordResetParams) error {
+	return p.queries.CreatePasswordReset(ctx, postgres.CreatePasswordResetParams(arg))
+}
+
+func (p *Postgres) ListPasswordResetsByUser(ctx context.Context, userID int64) ([]db.PasswordReset, error) {
+	resets, err := p.queries.ListPasswordResetsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	converted := make([]db.PasswordReset, len(resets))
+	for i, reset := range resets {
+		converted[i] = db.PasswordReset(reset)
+	}
+	return converted, nil
+}
+
+func (p *Postgres) DeletePasswordResetsByUser(ctx context.Context, userID int64) error {
+	return p.queries.DeletePasswordResetsByUser(ctx, userID)
+}
+
+func (p *Postgres) CreateSession(ctx context.Context, arg db.CreateSessionParams) error {
+	return p.queries.CreateSession(ctx, postgres.CreateSessionParams(arg))
+}
+
+func (p *Postgres) ListRecentSessionsByUser(ctx context.Context, arg db.ListRecentSessionsByUserParams) ([]db.Session, error) {
+	sessions, err := p.queries.ListRecentSessionsByUser(ctx, postgres.ListRecentSessionsByUserParams{
+		UserID: arg.UserID,
+		Limit:  int32(arg.Limit),
+	})
+	return fromPostgresSessions(sessions), err
+}
+
+func (p *Postgres) ListTopKillSessions(ctx context.Context, arg db.ListTopKillSessionsParams) ([]db.Session, error) {
+	sessions, err := p.queries.ListTopKillSessions(ctx, postgres.ListTopKillSessionsParams{
+		EndedAt: arg.EndedAt,
+		Limit:   int32(arg.Limit),
+	})
+	return fromPostgresSessions(sessions), err
+}
+
+func (p *Postgres) DeleteSessionsByUser(ctx context.Context, userID sql.NullInt64) error {
+	return p.queries.DeleteSessionsByUser(ctx, userID)
+}
+
+func (p *Postgres) GetSessionTotalsByUser(ctx context.Context, userID sql.NullInt64) (db.GetSessionTotalsByUserRow, error) {
+	totals, err := p.queries.GetSessionTotalsByUser(ctx, userID)
+	return db.GetSessionTotalsByUserRow(totals), err
+}
+
+func (p *Postgres) UnlockAchievement(ctx context.Context, arg db.UnlockAchievementParams) error {
+	return p.queries.UnlockAchievement(ctx, postgres.UnlockAchievementParams(arg))
+}
+
+func (p *Postgres) ListAchievementsByUser(ctx context.Context, userID int64) ([]db.UserAchievement, error) {
+	achievements, err := p.queries.ListAchievementsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	converted := make([]db.UserAchievement, len(achievements))
+	for i, achievement := range achievements {
+		converted[i] = db.UserAchievement(achievement)
+	}
+	return converted, nil
+}
+
+func (p *Postgres) DeleteAchievementsByUser(ctx context.Context, userID int64) error {
+	return p.queries.DeleteAchievementsByUser(ctx, userID)
+}
+
+func (p *Postgres) CreateFriendship(ctx context.Context, arg db.CreateFriendshipParams) error {
+	return p.queries.CreateFriendship(ctx, postgres.CreateFriendshipParams(arg))
+}
+
+func (p *Postgres) GetFriendship(ctx context.Context, arg db.GetFriendshipParams) (db.Friendship, error) {
+	friendship, err := p.queries.GetFriendship(ctx, postgres.GetFriendshipParams(arg))
+	return db.Friendship(friendship), err
+}
+
+func (p *Postgres) AcceptFriendship(ctx context.Context, arg db.AcceptFriendshipParams) (int64, error) {
+	return p.queries.AcceptFriendship(ctx, postgres.AcceptFriendshipParams(arg))
+}
+
+func (p *Postgres) ListFriendsByUser(ctx context.Context, userID int64) ([]db.ListFriendsByUserRow, error) {
+	friends, err := p.queries.ListFriendsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	converted := make([]db.ListFriendsByUserRow, len(friends))
+	for i, friend := range friends {
+		converted[i] = db.ListFriendsByUserRow(friend)
+	}
+	return converted, nil
+}
+
+func (p *Postgres) ListFriendRequestsToUser(ctx context.Context, friendID int64) ([]db.ListFriendRequestsToUserRow, error) {
+	requests, err := p.queries.ListFriendRequestsToUser(ctx, friendID)
+	if err != nil {
+		return nil, err
+	}
+
+	converted := make([]db.ListFriendRequestsToUserRow, len(requests))
+	for i, request := range requests {
+		converted[i] = db.ListFriendRequestsToUserRow(request)
+	}
+	return converted, nil
+}
+
+func (p *Postgres) DeleteFriendship(ctx context.Context, arg db.DeleteFriendshipParams) error {
+	return p.queries.DeleteFriendship(ctx, postgres.DeleteFriendshipParams(arg))
+}
+
+func (p *Postgres) DeleteFriendshipsByUser(ctx context.Context, arg db.DeleteFriendshipsByUserParams) error {
+	return p.queries.DeleteFriendshipsByUser(ctx, postgres.DeleteFriendshipsByUserParams(arg))
+}
+
+func (p *Postgres) GetUserRating(ctx context.Context, userID int64) (db.UserRating, error) {
+	rating, err := p.queries.GetUserRating(ctx, userID)
+	return db.UserRating(rating), err
+}
+
+func (p *Postgres) UpsertUserRating(ctx context.Context, arg db.UpsertUserRatingParams) error {
+	return p.queries.UpsertUserRating(ctx, postgres.UpsertUserRatingParams(arg))
+}
+
+func (p *Postgres) DeleteUserRating(ctx context.Context, userID int64) error {
+	return p.queries.DeleteUserRating(ctx, userID)
+}
+
+func (p *Postgres) CountUsersRatedAbove(ctx context.Context, rating float64) (int64, error) {
+	return p.queries.CountUsersRatedAbove(ctx, rating)
+}
+
+func (p *Postgres) ListTopRatings(ctx context.Context, limit int64) ([]db.ListTopRatingsRow, error) {
+	ratings, err := p.queries.ListTopRatings(ctx, int32(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	converted := make([]db.ListTopRatingsRow, len(ratings))
+	for i, rating := range ratings {
+		converted[i] = db.ListTopRatingsRow(rating)
+	}
+	return converted, nil
+}
+
+func (p *Postgres) CreateRatingChange(ctx context.Context, arg db.CreateRatingChangeParams) error {
+	return p.queries.CreateRatingChange(ctx, postgres.CreateRatingChangeParams(arg))
+}
+
+func (p *Postgres) ListRatingHistoryByUser(ctx context.Context, arg db.ListRatingHistoryByUserParams) ([]db.RatingHistory, error) {
+	history, err := p.queries.ListRatingHistoryByUser(ctx, postgres.ListRatingHistoryByUserParams{
+		UserID: arg.UserID,
+		Limit:  int32(arg.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	converted := make([]db.RatingHistory, len(history))
+	for i, change := range history {
+		converted[i] = db.RatingHistory(change)
+	}
+	return converted, nil
+}
+
+func (p *Postgres) DeleteRatingHistoryByUser(ctx context.Context, userID int64) error {
+	return p.queries.DeleteRatingHistoryByUser(ctx, userID)
+}
+
+func fromPostgresSessions(sessions []postgres.Session) []db.Session {
+	if sessions == nil {
+		return nil
+	}
+
+	converted := make([]db.Session, len(sessions))
+	for i, session := range sessions {
+		converted[i] = db.Session(session)
+	}
+	return converted
+}
+
+// The SQLite columns are wider, so the conversion can't lose anything
+func fromPostgresProfile(profile postgres.UserProfile) db.UserProfile {
+	return db.UserProfile{
+		UserID:      profile.UserID,
+		Color:       int64(profile.Color),
+		DisplayName: profile.DisplayName,
+		SkinID:      int64(profile.SkinID),
+		Settings:    profile.Settings,
+		UpdatedAt:   profile.UpdatedAt,
+	}
+}
@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"server/internal/server/db"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// User accounts kept in the local SQLite database, which has all of the queries already
+type SQLite struct {
+	*db.Queries
+	// nil inside a transaction
+	dbPool *sql.DB
+}
+
+func NewSQLite(dbPool *sql.DB) *SQLite {
+	return &SQLite{
+		Queries: db.New(dbPool),
+		dbPool:  dbPool,
+	}
+}
+
+func (s *SQLite) WithTx(ctx context.Context, fn func(users Users) error) error {
+	// Transactions don't nest, fn becomes part of the one we're in
+	if s.dbPool == nil {
+		return fn(s)
+	}
+
+	return RunInTx(ctx, s.dbPool, func(tx *sql.Tx) error {
+		return fn(&SQLite{Queries: s.Queries.WithTx(tx)})
+	})
+}
+
+func (s *SQLite) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+	user, err := s.Queries.CreateUser(ctx, arg)
+	if sqliteErr := (*sqlite.Error)(nil); errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+		return db.User{}, ErrUserExists
+	}
+	return user, err
+}
@@ -0,0 +1,77 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"server/internal/server/db"
+)
+
+// CreateUser fails with this when there is a user with the name already, also when they were added concurrently
+var ErrUserExists = errors.New("the user already exists")
+
+// The states of a friendship
+const (
+	FriendshipPending  = "pending"
+	FriendshipAccepted = "accepted"
+)
+
+// Everything stored about user accounts: the users themselves, their profiles, password reset codes, the history
+// of the games they played, their achievements, their friends and their ratings. Accounts are kept in the local
+// SQLite database by default, or in PostgreSQL so several game servers can share them.
+// Either way the types of the SQLite queries are used, so callers don't need to know which one they're talking to.
+type Users interface {
+	GetUserByID(ctx context.Context, id int64) (db.User, error)
+	GetUserByUsername(ctx context.Context, username string) (db.User, error)
+	CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error)
+	UpdateUserPassword(ctx context.Context, arg db.UpdateUserPasswordParams) error
+	SetUserRole(ctx context.Context, arg db.SetUserRoleParams) (int64, error)
+	DeleteUser(ctx context.Context, id int64) error
+
+	GetUserProfile(ctx context.Context, userID int64) (db.UserProfile, error)
+	// Another user's profile with the given display name, which has to be normalized
+	GetUserProfileByDisplayName(ctx context.Context, arg db.GetUserProfileByDisplayNameParams) (db.UserProfile, error)
+	UpsertUserProfile(ctx context.Context, arg db.UpsertUserProfileParams) (db.UserProfile, error)
+	DeleteUserProfile(ctx context.Context, userID int64) error
+
+	CreatePasswordReset(ctx context.Context, arg db.CreatePasswordResetParams) error
+	ListPasswordResetsByUser(ctx context.Context, userID int64) ([]db.PasswordReset, error)
+	DeletePasswordResetsByUser(ctx context.Context, userID int64) error
+
+	// Guests' sessions are kept too, without a user id
+	CreateSession(ctx context.Context, arg db.CreateSessionParams) error
+	ListRecentSessionsByUser(ctx context.Context, arg db.ListRecentSessionsByUserParams) ([]db.Session, error)
+	ListTopKillSessions(ctx context.Context, arg db.ListTopKillSessionsParams) ([]db.Session, error)
+	DeleteSessionsByUser(ctx context.Context, userID sql.NullInt64) error
+	// How many spores and players a user ate over all of their sessions
+	GetSessionTotalsByUser(ctx context.Context, userID sql.NullInt64) (db.GetSessionTotalsByUserRow, error)
+
+	// Unlocking an achievement a user already has does nothing
+	UnlockAchievement(ctx context.Context, arg db.UnlockAchievementParams) error
+	ListAchievementsByUser(ctx context.Context, userID int64) ([]db.UserAchievement, error)
+	DeleteAchievementsByUser(ctx context.Context, userID int64) error
+
+	// A request is stored from the user who sent it. Once it's accepted there is a friendship each way.
+	CreateFriendship(ctx context.Context, arg db.CreateFriendshipParams) error
+	GetFriendship(ctx context.Context, arg db.GetFriendshipParams) (db.Friendship, error)
+	AcceptFriendship(ctx context.Context, arg db.AcceptFriendshipParams) (int64, error)
+	ListFriendsByUser(ctx context.Context, userID int64) ([]db.ListFriendsByUserRow, error)
+	ListFriendRequestsToUser(ctx context.Context, friendID int64) ([]db.ListFriendRequestsToUserRow, error)
+	DeleteFriendship(ctx context.Context, arg db.DeleteFriendshipParams) error
+	DeleteFriendshipsByUser(ctx context.Context, arg db.DeleteFriendshipsByUserParams) error
+
+	// Users who never played a rated game have no rating yet
+	GetUserRating(ctx context.Context, userID int64) (db.UserRating, error)
+	UpsertUserRating(ctx context.Context, arg db.UpsertUserRatingParams) error
+	DeleteUserRating(ctx context.Context, userID int64) error
+	// The number of users rated higher, so a user's rank is one more
+	CountUsersRatedAbove(ctx context.Context, rating float64) (int64, error)
+	ListTopRatings(ctx context.Context, limit int64) ([]db.ListTopRatingsRow, error)
+	// Every change to a user's rating is kept, along with the game that caused it
+	CreateRatingChange(ctx context.Context, arg db.CreateRatingChangeParams) error
+	ListRatingHistoryByUser(ctx context.Context, arg db.ListRatingHistoryByUserParams) ([]db.RatingHistory, error)
+	DeleteRatingHistoryByUser(ctx context.Context, userID int64) error
+
+	// Run fn in a transaction with the Users it's given, committing if it returns nil and rolling back otherwise
+	WithTx(ctx context.Context, fn func(users Users) error) error
+}
@@ -0,0 +1,21 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Run fn in a transaction, which is committed if fn returns nil and rolled back otherwise
+func RunInTx(ctx context.Context, dbPool *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := dbPool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	// Does nothing once the transaction is committed
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
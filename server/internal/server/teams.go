@@ -0,0 +1,128 @@
+package server
+
+import (
+	"sync"
+)
+
+// Colours used for the teams in RGBA format, team n uses teamColors[(n-1) % len(teamColors)]
+var teamColors = []uint32{
+	0xE74C3CFF, // red
+	0x3498DBFF, // blue
+	0x2ECC71FF, // green
+	0xF1C40FFF, // yellow
+	0x9B59B6FF, // purple
+	0xE67E22FF, // orange
+}
+
+// Keeps track of which team each player is on. Teams are numbered from 1, team 0 means no team (free-for-all).
+type Teams struct {
+	count int
+	// The client ids currently playing on each team
+	members map[int32]map[uint64]struct{}
+	// The last team each player name was assigned to, so players land on the same team when they reconnect
+	assigned map[string]int32
+	mux      sync.Mutex
+}
+
+func NewTeams(count int) *Teams {
+	members := make(map[int32]map[uint64]struct{}, count)
+	for team := int32(1); team <= int32(count); team++ {
+		members[team] = make(map[uint64]struct{})
+	}
+
+	return &Teams{
+		count:    count,
+		members:  members,
+		assigned: make(map[string]int32),
+	}
+}
+
+// Whether the game is played in teams or free-for-all
+func (t *Teams) Enabled() bool {
+	return t.count > 0
+}
+
+// The number of teams in the game
+func (t *Teams) Count() int {
+	return t.count
+}
+
+// Assign a player to a team and return it. Players join the team of the first of their party mates who's on one.
+// Otherwise a player who played before keeps their previous team unless joining it would make it bigger than the
+// smallest team by more than one, and the smallest team is picked.
+func (t *Teams) Assign(clientId uint64, name string, mates []uint64) int32 {
+	if !t.Enabled() {
+		return 0
+	}
+
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	// Make sure the client isn't counted twice if it was already on a team
+	for _, members := range t.members {
+		delete(members, clientId)
+	}
+
+	smallest := int32(1)
+	for team := int32(2); team <= int32(t.count); team++ {
+		if len(t.members[team]) < len(t.members[smallest]) {
+			smallest = team
+		}
+	}
+
+	team := smallest
+	if previous, ok := t.assigned[name]; ok && len(t.members[previous]) <= len(t.members[smallest]) {
+		team = previous
+	}
+	if mateTeam := t.teamOf(mates); mateTeam != 0 {
+		team = mateTeam
+	}
+
+	t.members[team][clientId] = struct{}{}
+	t.assigned[name] = team
+	return team
+}
+
+func (t *Teams) teamOf(clientIds []uint64) int32 {
+	for _, clientId := range clientIds {
+		for team, members := range t.members {
+			if _, exists := members[clientId]; exists {
+				return team
+			}
+		}
+	}
+	return 0
+}
+
+// Remove a player from their team, the assignment is remembered for when they come back
+func (t *Teams) Leave(clientId uint64) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	for _, members := range t.members {
+		delete(members, clientId)
+	}
+}
+
+// Get the team a player was last assigned to, which they stay on while they're dead or spectating, or 0 if they
+// haven't been on a team
+func (t *Teams) Last(name string) int32 {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	return t.assigned[name]
+}
+
+// Get the colour of a team in RGBA format
+func (t *Teams) Color(team int32) int32 {
+	if team <= 0 {
+		return 0
+	}
+	return int32(teamColors[int(team-1)%len(teamColors)])
+}
+
+// Get the number of players currently on a team
+func (t *Teams) Size(team int32) int {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	return len(t.members[team])
+}
@@ -0,0 +1,105 @@
+package server
+
+import "testing"
+
+func TestTeamsDisabled(t *testing.T) {
+	teams := NewTeams(0)
+	if teams.Enabled() {
+		t.Error("expected no teams to mean free-for-all")
+	}
+	if team := teams.Assign(1, "bob", nil); team != 0 {
+		t.Errorf("expected free-for-all players not to be on a team, got %d", team)
+	}
+	if color := teams.Color(0); color != 0 {
+		t.Errorf("expected no colour without a team, got %x", color)
+	}
+}
+
+func TestTeamsBalance(t *testing.T) {
+	teams := NewTeams(3)
+	for clientId, want := range []int32{1, 2, 3, 1, 2, 3} {
+		if team := teams.Assign(uint64(clientId), string(rune('a'+clientId)), nil); team != want {
+			t.Errorf("player %d: expected team %d, got %d", clientId, want, team)
+		}
+	}
+	for team := int32(1); team <= 3; team++ {
+		if size := teams.Size(team); size != 2 {
+			t.Errorf("expected team %d to have 2 players, got %d", team, size)
+		}
+	}
+
+	// Assigning a player again doesn't count them twice
+	teams.Assign(0, "a", nil)
+	if size := teams.Size(1); size != 2 {
+		t.Errorf("expected team 1 to still have 2 players, got %d", size)
+	}
+}
+
+func TestTeamsRemembered(t *testing.T) {
+	teams := NewTeams(2)
+	teams.Assign(1, "alice", nil)
+	teams.Assign(2, "bob", nil)
+
+	// Alice is still on her team while she's not playing
+	teams.Leave(1)
+	if team := teams.Last("alice"); team != 1 {
+		t.Errorf("expected alice to still be on team 1, got %d", team)
+	}
+
+	// Alice comes back to her team while it's no bigger than the others
+	if team := teams.Assign(3, "alice", nil); team != 1 {
+		t.Errorf("expected alice to return to team 1, got %d", team)
+	}
+
+	// Bob can't go back to his team when it would be two players bigger than the other
+	teams.Leave(2)
+	teams.Assign(4, "carol", nil)
+	teams.Assign(5, "dave", []uint64{4})
+	if team := teams.Assign(6, "bob", nil); team != 1 {
+		t.Errorf("expected bob to be moved to the smaller team 1, got %d", team)
+	}
+}
+
+func TestTeamsParty(t *testing.T) {
+	teams := NewTeams(2)
+	teams.Assign(1, "alice", nil)
+	// Party mates join the team of the first mate on one, even if it's the bigger team
+	if team := teams.Assign(2, "bob", []uint64{99, 1}); team != 1 {
+		t.Errorf("expected bob to join alice on team 1, got %d", team)
+	}
+	if team := teams.Assign(3, "carol", []uint64{99}); team != 2 {
+		t.Errorf("expected carol to be placed on the smaller team 2 without mates in the game, got %d", team)
+	}
+}
+
+func TestTeamColors(t *testing.T) {
+	teams := NewTeams(len(teamColors) + 1)
+	if teams.Color(1) != teams.Color(int32(len(teamColors)+1)) {
+		t.Error("expected the colours to wrap around")
+	}
+	if teams.Color(1) == teams.Color(2) {
+		t.Error("expected neighbouring teams to have different colours")
+	}
+}
+
+func TestRoomsHaveTeamsOfTheirOwn(t *testing.T) {
+	config := DefaultConfig()
+	config.Rooms = []RoomConfig{{Name: "teams", Teams: 2}}
+	rooms := NewRooms(config, NewChatLog(config.ChatHistorySize))
+	rooms.Start()
+
+	first, _ := rooms.Get(config.Room)
+	if first.SharedGameObjects.Teams.Enabled() {
+		t.Error("expected the first room to be free-for-all")
+	}
+	teamRoom, exists := rooms.Get("teams")
+	if !exists {
+		t.Fatal("expected the configured room to be open")
+	}
+	if count := teamRoom.SharedGameObjects.Teams.Count(); count != 2 {
+		t.Errorf("expected the configured room to have 2 teams, got %d", count)
+	}
+	if !teamRoom.permanent {
+		t.Error("expected the configured room to stay open while it's empty")
+	}
+}
@@ -0,0 +1,41 @@
+package validation
+
+import (
+	"sync"
+	"time"
+)
+
+// A token bucket holds up to capacity tokens and refills at a steady rate, each allowed event takes one token
+type TokenBucket struct {
+	capacity   float64
+	refillRate float64 // tokens per second
+	tokens     float64
+	last       time.Time
+	mux        sync.Mutex
+}
+
+// Create a full bucket which allows bursts of capacity events and refillRate events per second on average
+func NewTokenBucket(capacity, refillRate float64) *TokenBucket {
+	return &TokenBucket{
+		capacity:   capacity,
+		refillRate: refillRate,
+		tokens:     capacity,
+		last:       time.Now(),
+	}
+}
+
+// Take a token if there is one, returns false if the bucket is empty
+func (b *TokenBucket) Allow() bool {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	now := time.Now()
+	b.tokens = min(b.capacity, b.tokens+now.Sub(b.last).Seconds()*b.refillRate)
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
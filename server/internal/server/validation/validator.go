@@ -0,0 +1,163 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"server/pkg/packets"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The longest string a client may send in any field
+const MaxStringLength = 256
+
+// How many packets of one type a client may send in a burst, and per second on average
+type Limit struct {
+	Burst     float64
+	PerSecond float64
+	// Packets over the limit are dropped without counting against the client, for gameplay packets which the game
+	// client sends in bursts during normal play
+	DropOnly bool
+}
+
+// Returned for packets over a DropOnly limit, they're dropped quietly
+var ErrThrottled = errors.New("packet dropped by the rate limit")
+
+// Limits for the packets clients send the most, or which are expensive to handle
+var limits = map[string]Limit{
+	// The game client sends its direction every physics frame the mouse moves far enough, up to 60 times a second,
+	// and reports every spore it touches, many at once while the magnet pulls them in
+	"*packets.Packet_PlayerDirection": {Burst: 120, PerSecond: 60, DropOnly: true},
+	"*packets.Packet_SporeConsumed":   {Burst: 200, PerSecond: 60, DropOnly: true},
+	"*packets.Packet_Chat":            {Burst: 5, PerSecond: 1},
+	"*packets.Packet_LoginRequest":    {Burst: 3, PerSecond: 0.5},
+	"*packets.Packet_RegisterRequest": {Burst: 3, PerSecond: 0.5},
+	"*packets.Packet_ReportMessage":   {Burst: 3, PerSecond: 0.1},
+	// These compare and hash passwords, which is slow on purpose
+	"*packets.Packet_ChangePasswordRequest": {Burst: 3, PerSecond: 0.5},
+	"*packets.Packet_ResetPasswordRequest":  {Burst: 3, PerSecond: 0.5},
+	"*packets.Packet_DeleteAccountRequest":  {Burst: 3, PerSecond: 0.5},
+	"*packets.Packet_HistoryRequest":        {Burst: 3, PerSecond: 0.5},
+	// These query the accounts database
+	"*packets.Packet_AddFriendRequest":     {Burst: 5, PerSecond: 1},
+	"*packets.Packet_RemoveFriendRequest":  {Burst: 5, PerSecond: 1},
+	"*packets.Packet_FriendListRequest":    {Burst: 5, PerSecond: 1},
+	"*packets.Packet_JoinFriendRequest":    {Burst: 3, PerSecond: 0.5},
+	"*packets.Packet_PlayerProfileRequest": {Burst: 5, PerSecond: 1},
+	"*packets.Packet_LeaderboardRequest":   {Burst: 3, PerSecond: 0.5},
+	// Invitations reach other players, so they mustn't be spammed
+	"*packets.Packet_PartyInviteRequest": {Burst: 5, PerSecond: 0.5},
+}
+
+// The limit for any packet type not listed above
+var defaultLimit = Limit{Burst: 50, PerSecond: 20}
+
+// A client may break the rules this many times in a burst, and once every violationCooldown seconds on average,
+// before it's considered a repeat offender
+const (
+	maxViolations     = 10
+	violationCooldown = 5
+)
+
+// Checks every packet a client sends before it's processed
+type Validator struct {
+	buckets        map[string]*TokenBucket
+	violations     *TokenBucket
+	repeatOffender bool
+}
+
+func NewValidator() *Validator {
+	return &Validator{
+		buckets:    make(map[string]*TokenBucket),
+		violations: NewTokenBucket(maxViolations, 1.0/violationCooldown),
+	}
+}
+
+// Check the packet is within its rate limit and its contents are sane, returns an error describing why it was rejected
+func (v *Validator) Validate(packet *packets.Packet) error {
+	if packet.Msg == nil {
+		return v.violation(fmt.Errorf("packet has no message"))
+	}
+
+	msgType := fmt.Sprintf("%T", packet.Msg)
+	limit, ok := limits[msgType]
+	if !ok {
+		limit = defaultLimit
+	}
+	bucket, exists := v.buckets[msgType]
+	if !exists {
+		bucket = NewTokenBucket(limit.Burst, limit.PerSecond)
+		v.buckets[msgType] = bucket
+	}
+
+	if !bucket.Allow() {
+		if limit.DropOnly {
+			return ErrThrottled
+		}
+		return v.violation(fmt.Errorf("rate limit exceeded for %s", msgType))
+	}
+
+	if err := validateFields(packet.ProtoReflect()); err != nil {
+		return v.violation(err)
+	}
+
+	return nil
+}
+
+// Whether packets of the message's type are dropped quietly when they're over their limit. The game client sends them
+// in bursts during normal play.
+func DropOnly(msg packets.Msg) bool {
+	return limits[fmt.Sprintf("%T", msg)].DropOnly
+}
+
+// Whether the client has broken the rules too often and should be disconnected
+func (v *Validator) RepeatOffender() bool {
+	return v.repeatOffender
+}
+
+func (v *Validator) violation(err error) error {
+	if !v.violations.Allow() {
+		v.repeatOffender = true
+	}
+	return err
+}
+
+// Recursively check that no floating point field is NaN or infinite and no string is too long
+func validateFields(message protoreflect.Message) error {
+	var err error
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.IsList():
+			list := value.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = validateValue(field, list.Get(i))
+			}
+		case field.IsMap():
+			value.Map().Range(func(_ protoreflect.MapKey, mapValue protoreflect.Value) bool {
+				err = validateValue(field.MapValue(), mapValue)
+				return err == nil
+			})
+		default:
+			err = validateValue(field, value)
+		}
+		return err == nil
+	})
+	return err
+}
+
+func validateValue(field protoreflect.FieldDescriptor, value protoreflect.Value) error {
+	switch field.Kind() {
+	case protoreflect.DoubleKind, protoreflect.FloatKind:
+		if f := value.Float(); math.IsNaN(f) || math.IsInf(f, 0) {
+			return fmt.Errorf("field %s is not a finite number", field.Name())
+		}
+	case protoreflect.StringKind:
+		if len(value.String()) > MaxStringLength {
+			return fmt.Errorf("field %s is longer than %d bytes", field.Name(), MaxStringLength)
+		}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return validateFields(value.Message())
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package validation
+
+import (
+	"errors"
+	"math"
+	"server/pkg/packets"
+	"strings"
+	"testing"
+)
+
+func chat(msg string) *packets.Packet {
+	return &packets.Packet{Msg: packets.NewChat(msg)}
+}
+
+func direction(direction float64) *packets.Packet {
+	return &packets.Packet{Msg: &packets.Packet_PlayerDirection{PlayerDirection: &packets.PlayerDirectionMessage{Direction: direction}}}
+}
+
+func TestTokenBucket(t *testing.T) {
+	bucket := NewTokenBucket(3, 0)
+	for i := range 3 {
+		if !bucket.Allow() {
+			t.Fatalf("expected event %d of the burst to be allowed", i+1)
+		}
+	}
+	if bucket.Allow() {
+		t.Error("expected the event after the burst to be refused")
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	v := NewValidator()
+	limit := limits["*packets.Packet_Chat"]
+	for i := range int(limit.Burst) {
+		if err := v.Validate(chat("hello")); err != nil {
+			t.Fatalf("expected chat %d of the burst to be allowed, got %v", i+1, err)
+		}
+	}
+	err := v.Validate(chat("hello"))
+	if err == nil {
+		t.Fatal("expected the chat after the burst to be refused")
+	}
+	if errors.Is(err, ErrThrottled) {
+		t.Error("expected chat over the limit to count as a violation, not to be dropped quietly")
+	}
+
+	// Each type has a bucket of its own
+	if err := v.Validate(direction(1)); err != nil {
+		t.Errorf("expected a direction to be allowed after chat spam, got %v", err)
+	}
+}
+
+func TestDropOnlyIsNotAViolation(t *testing.T) {
+	v := NewValidator()
+	limit := limits["*packets.Packet_PlayerDirection"]
+	if !limit.DropOnly || !DropOnly(direction(0).Msg) {
+		t.Fatal("expected directions to be dropped quietly")
+	}
+	if DropOnly(chat("hello").Msg) {
+		t.Error("expected chat not to be dropped quietly")
+	}
+
+	for i := range int(limit.Burst) * 2 {
+		err := v.Validate(direction(float64(i)))
+		if i < int(limit.Burst) && err != nil {
+			t.Fatalf("expected direction %d of the burst to be allowed, got %v", i+1, err)
+		}
+		if i >= int(limit.Burst) && !errors.Is(err, ErrThrottled) {
+			t.Fatalf("expected direction %d to be throttled, got %v", i+1, err)
+		}
+	}
+	if v.RepeatOffender() {
+		t.Error("expected throttled directions not to make the client a repeat offender")
+	}
+}
+
+func TestFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		packet *packets.Packet
+		valid  bool
+	}{
+		{"plain chat", chat("hello"), true},
+		{"longest chat", chat(strings.Repeat("a", MaxStringLength)), true},
+		{"chat too long", chat(strings.Repeat("a", MaxStringLength+1)), false},
+		{"direction", direction(math.Pi), true},
+		{"NaN direction", direction(math.NaN()), false},
+		{"infinite direction", direction(math.Inf(-1)), false},
+		{"no message", &packets.Packet{}, false},
+	}
+
+	for _, test := range tests {
+		err := NewValidator().Validate(test.packet)
+		if test.valid && err != nil {
+			t.Errorf("%s: expected it to be valid, got %v", test.name, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("%s: expected it to be rejected", test.name)
+		}
+	}
+}
+
+func TestRepeatOffender(t *testing.T) {
+	v := NewValidator()
+	for i := range maxViolations {
+		v.Validate(direction(math.NaN()))
+		if v.RepeatOffender() {
+			t.Fatalf("expected %d violations to be tolerated", i+1)
+		}
+	}
+	v.Validate(direction(math.NaN()))
+	if !v.RepeatOffender() {
+		t.Errorf("expected the client to be a repeat offender after %d violations", maxViolations+1)
+	}
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Returned when too many jobs are waiting for a worker already
+var ErrWorkersBusy = errors.New("too many jobs waiting for a worker")
+
+// Runs database queries and password hashing off the clients' read pumps, so a slow query or hash doesn't hold up
+// every other packet of the client waiting for it. Jobs are refused once the queue is full rather than piling up.
+type WorkerPool struct {
+	jobs    chan job
+	timeout time.Duration
+}
+
+type job struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	client ClientInterfacer
+	// The state the client was in when the job was submitted
+	state ClientStateHandler
+	work  func(ctx context.Context) error
+	done  func(err error)
+}
+
+func NewWorkerPool(workers int, queueSize int, timeout time.Duration) *WorkerPool {
+	p := &WorkerPool{
+		jobs:    make(chan job, queueSize),
+		timeout: timeout,
+	}
+	for range workers {
+		go p.runWorker()
+	}
+	return p
+}
+
+// Queue work for a worker. Once it's finished, done is passed its error through a StateTask queued to the client,
+// which only runs if the client is still in the state it was in when the job was submitted; done may be nil if
+// nothing needs to happen afterwards. Safe to call from any goroutine, but done is only of use when called from the
+// client's own, since other goroutines can't tell which state the client is in. The timeout starts counting now, so
+// a job which waited too long in the queue fails without running.
+func (p *WorkerPool) Submit(client ClientInterfacer, work func(ctx context.Context) error, done func(err error)) error {
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	select {
+	case p.jobs <- job{ctx: ctx, cancel: cancel, client: client, state: client.State(), work: work, done: done}:
+		return nil
+	default:
+		cancel()
+		return ErrWorkersBusy
+	}
+}
+
+func (p *WorkerPool) runWorker() {
+	for job := range p.jobs {
+		err := job.ctx.Err()
+		if err == nil {
+			err = job.work(job.ctx)
+		}
+		job.cancel()
+
+		if job.done == nil {
+			continue
+		}
+		done := job.done
+		QueueTask(job.client, job.state, func() { done(err) })
+	}
+}
@@ -0,0 +1,128 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"server/pkg/packets"
+	"testing"
+	"time"
+)
+
+type testState struct{}
+
+func (s *testState) Name() string                                   { return "Test" }
+func (s *testState) SetClient(client ClientInterfacer)              {}
+func (s *testState) OnEnter()                                       {}
+func (s *testState) HandleMessage(senderId uint64, msg packets.Msg) {}
+func (s *testState) OnExit()                                        {}
+
+// A client which only keeps track of its state and what's queued to it
+type testClient struct {
+	ClientInterfacer
+	state  ClientStateHandler
+	queued chan LocalMsg
+}
+
+func newTestClient() *testClient {
+	return &testClient{state: &testState{}, queued: make(chan LocalMsg, 10)}
+}
+
+func (c *testClient) State() ClientStateHandler {
+	return c.state
+}
+
+func (c *testClient) Queue(message LocalMsg) {
+	c.queued <- message
+}
+
+func (c *testClient) nextTask(t *testing.T) *StateTask {
+	t.Helper()
+	select {
+	case message := <-c.queued:
+		task, ok := message.(*StateTask)
+		if !ok {
+			t.Fatalf("expected a state task, got %T", message)
+		}
+		return task
+	case <-time.After(time.Second):
+		t.Fatal("expected the job's callback to be queued")
+		return nil
+	}
+}
+
+func TestWorkerPoolRejectsWhenQueueIsFull(t *testing.T) {
+	pool := NewWorkerPool(1, 1, time.Second)
+	client := newTestClient()
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+
+	// The only worker is kept busy, so one more job fits in the queue and the next one doesn't
+	err := pool.Submit(client, func(ctx context.Context) error {
+		close(started)
+		<-release
+		return nil
+	}, nil)
+	if err != nil {
+		t.Fatalf("expected the first job to be accepted, got %v", err)
+	}
+	<-started
+	if err := pool.Submit(client, func(ctx context.Context) error { return nil }, nil); err != nil {
+		t.Fatalf("expected the second job to be queued, got %v", err)
+	}
+	if err := pool.Submit(client, func(ctx context.Context) error { return nil }, nil); !errors.Is(err, ErrWorkersBusy) {
+		t.Errorf("expected the third job to be rejected, got %v", err)
+	}
+}
+
+func TestWorkerPoolQueuesCallbackForSubmittingState(t *testing.T) {
+	pool := NewWorkerPool(1, 1, time.Second)
+	client := newTestClient()
+	submittedIn := client.state
+	errFailed := errors.New("failed")
+
+	var got error
+	if err := pool.Submit(client, func(ctx context.Context) error { return errFailed }, func(err error) { got = err }); err != nil {
+		t.Fatal(err)
+	}
+	task := client.nextTask(t)
+
+	// The client drops tasks of a state it has left, which is how the callback is dropped
+	client.state = &testState{}
+	if task.State != submittedIn {
+		t.Error("expected the callback to belong to the state the job was submitted in")
+	}
+	task.Run()
+	if !errors.Is(got, errFailed) {
+		t.Errorf("expected the callback to get the job's error, got %v", got)
+	}
+}
+
+func TestWorkerPoolTimesOutQueuedJobs(t *testing.T) {
+	pool := NewWorkerPool(1, 1, 10*time.Millisecond)
+	client := newTestClient()
+	started, release := make(chan struct{}), make(chan struct{})
+
+	pool.Submit(client, func(ctx context.Context) error {
+		close(started)
+		<-release
+		return nil
+	}, nil)
+	<-started
+
+	ran := false
+	var got error
+	pool.Submit(client, func(ctx context.Context) error {
+		ran = true
+		return nil
+	}, func(err error) { got = err })
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+
+	client.nextTask(t).Run()
+	if ran {
+		t.Error("expected a job which waited too long not to run")
+	}
+	if !errors.Is(got, context.DeadlineExceeded) {
+		t.Errorf("expected the job to time out, got %v", got)
+	}
+}
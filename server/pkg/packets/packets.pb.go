@@ -22,9 +22,223 @@ const (
 )
 
 // Define your messages
+type RoundPhase int32
+
+const (
+	RoundPhase_ROUND_PHASE_NONE    RoundPhase = 0
+	RoundPhase_ROUND_PHASE_LOBBY   RoundPhase = 1
+	RoundPhase_ROUND_PHASE_RUNNING RoundPhase = 2
+	RoundPhase_ROUND_PHASE_ENDED   RoundPhase = 3
+)
+
+// Enum value maps for RoundPhase.
+var (
+	RoundPhase_name = map[int32]string{
+		0: "ROUND_PHASE_NONE",
+		1: "ROUND_PHASE_LOBBY",
+		2: "ROUND_PHASE_RUNNING",
+		3: "ROUND_PHASE_ENDED",
+	}
+	RoundPhase_value = map[string]int32{
+		"ROUND_PHASE_NONE":    0,
+		"ROUND_PHASE_LOBBY":   1,
+		"ROUND_PHASE_RUNNING": 2,
+		"ROUND_PHASE_ENDED":   3,
+	}
+)
+
+func (x RoundPhase) Enum() *RoundPhase {
+	p := new(RoundPhase)
+	*p = x
+	return p
+}
+
+func (x RoundPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoundPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_packets_proto_enumTypes[0].Descriptor()
+}
+
+func (RoundPhase) Type() protoreflect.EnumType {
+	return &file_packets_proto_enumTypes[0]
+}
+
+func (x RoundPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoundPhase.Descriptor instead.
+func (RoundPhase) EnumDescriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{0}
+}
+
+type ChatTarget int32
+
+const (
+	ChatTarget_CHAT_TARGET_GLOBAL ChatTarget = 0
+	ChatTarget_CHAT_TARGET_TEAM   ChatTarget = 1
+	ChatTarget_CHAT_TARGET_ROOM   ChatTarget = 2
+	ChatTarget_CHAT_TARGET_DIRECT ChatTarget = 3
+)
+
+// Enum value maps for ChatTarget.
+var (
+	ChatTarget_name = map[int32]string{
+		0: "CHAT_TARGET_GLOBAL",
+		1: "CHAT_TARGET_TEAM",
+		2: "CHAT_TARGET_ROOM",
+		3: "CHAT_TARGET_DIRECT",
+	}
+	ChatTarget_value = map[string]int32{
+		"CHAT_TARGET_GLOBAL": 0,
+		"CHAT_TARGET_TEAM":   1,
+		"CHAT_TARGET_ROOM":   2,
+		"CHAT_TARGET_DIRECT": 3,
+	}
+)
+
+func (x ChatTarget) Enum() *ChatTarget {
+	p := new(ChatTarget)
+	*p = x
+	return p
+}
+
+func (x ChatTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_packets_proto_enumTypes[1].Descriptor()
+}
+
+func (ChatTarget) Type() protoreflect.EnumType {
+	return &file_packets_proto_enumTypes[1]
+}
+
+func (x ChatTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatTarget.Descriptor instead.
+func (ChatTarget) EnumDescriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{1}
+}
+
+type PowerUpKind int32
+
+const (
+	PowerUpKind_POWER_UP_NONE            PowerUpKind = 0
+	PowerUpKind_POWER_UP_SPEED           PowerUpKind = 1
+	PowerUpKind_POWER_UP_SHIELD          PowerUpKind = 2
+	PowerUpKind_POWER_UP_MAGNET          PowerUpKind = 3
+	PowerUpKind_POWER_UP_MASS_MULTIPLIER PowerUpKind = 4
+)
+
+// Enum value maps for PowerUpKind.
+var (
+	PowerUpKind_name = map[int32]string{
+		0: "POWER_UP_NONE",
+		1: "POWER_UP_SPEED",
+		2: "POWER_UP_SHIELD",
+		3: "POWER_UP_MAGNET",
+		4: "POWER_UP_MASS_MULTIPLIER",
+	}
+	PowerUpKind_value = map[string]int32{
+		"POWER_UP_NONE":            0,
+		"POWER_UP_SPEED":           1,
+		"POWER_UP_SHIELD":          2,
+		"POWER_UP_MAGNET":          3,
+		"POWER_UP_MASS_MULTIPLIER": 4,
+	}
+)
+
+func (x PowerUpKind) Enum() *PowerUpKind {
+	p := new(PowerUpKind)
+	*p = x
+	return p
+}
+
+func (x PowerUpKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PowerUpKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_packets_proto_enumTypes[2].Descriptor()
+}
+
+func (PowerUpKind) Type() protoreflect.EnumType {
+	return &file_packets_proto_enumTypes[2]
+}
+
+func (x PowerUpKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PowerUpKind.Descriptor instead.
+func (PowerUpKind) EnumDescriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{2}
+}
+
+type FriendshipState int32
+
+const (
+	FriendshipState_FRIENDSHIP_STATE_NONE             FriendshipState = 0
+	FriendshipState_FRIENDSHIP_STATE_FRIENDS          FriendshipState = 1
+	FriendshipState_FRIENDSHIP_STATE_REQUEST_SENT     FriendshipState = 2
+	FriendshipState_FRIENDSHIP_STATE_REQUEST_RECEIVED FriendshipState = 3
+)
+
+// Enum value maps for FriendshipState.
+var (
+	FriendshipState_name = map[int32]string{
+		0: "FRIENDSHIP_STATE_NONE",
+		1: "FRIENDSHIP_STATE_FRIENDS",
+		2: "FRIENDSHIP_STATE_REQUEST_SENT",
+		3: "FRIENDSHIP_STATE_REQUEST_RECEIVED",
+	}
+	FriendshipState_value = map[string]int32{
+		"FRIENDSHIP_STATE_NONE":             0,
+		"FRIENDSHIP_STATE_FRIENDS":          1,
+		"FRIENDSHIP_STATE_REQUEST_SENT":     2,
+		"FRIENDSHIP_STATE_REQUEST_RECEIVED": 3,
+	}
+)
+
+func (x FriendshipState) Enum() *FriendshipState {
+	p := new(FriendshipState)
+	*p = x
+	return p
+}
+
+func (x FriendshipState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FriendshipState) Descriptor() protoreflect.EnumDescriptor {
+	return file_packets_proto_enumTypes[3].Descriptor()
+}
+
+func (FriendshipState) Type() protoreflect.EnumType {
+	return &file_packets_proto_enumTypes[3]
+}
+
+func (x FriendshipState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FriendshipState.Descriptor instead.
+func (FriendshipState) EnumDescriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{3}
+}
+
 type ChatMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Msg           string                 `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	Target        ChatTarget             `protobuf:"varint,2,opt,name=target,proto3,enum=packets.ChatTarget" json:"target,omitempty"`
+	TargetId      uint64                 `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	System        bool                   `protobuf:"varint,4,opt,name=system,proto3" json:"system,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,6 +280,27 @@ func (x *ChatMessage) GetMsg() string {
 	return ""
 }
 
+func (x *ChatMessage) GetTarget() ChatTarget {
+	if x != nil {
+		return x.Target
+	}
+	return ChatTarget_CHAT_TARGET_GLOBAL
+}
+
+func (x *ChatMessage) GetTargetId() uint64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetSystem() bool {
+	if x != nil {
+		return x.System
+	}
+	return false
+}
+
 type IdMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -115,6 +350,7 @@ type LoginRequestMessage struct {
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	Color         int32                  `protobuf:"varint,3,opt,name=color,proto3" json:"color,omitempty"`
+	Region        string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -170,10 +406,18 @@ func (x *LoginRequestMessage) GetColor() int32 {
 	return 0
 }
 
+func (x *LoginRequestMessage) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
 type GuestLoginRequestMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	Color         int32                  `protobuf:"varint,2,opt,name=color,proto3" json:"color,omitempty"`
+	Region        string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -222,6 +466,13 @@ func (x *GuestLoginRequestMessage) GetColor() int32 {
 	return 0
 }
 
+func (x *GuestLoginRequestMessage) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
 type RegisterRequestMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
@@ -364,6 +615,8 @@ type PlayerMessage struct {
 	Direction     float64                `protobuf:"fixed64,6,opt,name=direction,proto3" json:"direction,omitempty"`
 	Speed         float64                `protobuf:"fixed64,7,opt,name=speed,proto3" json:"speed,omitempty"`
 	Color         int32                  `protobuf:"varint,8,opt,name=color,proto3" json:"color,omitempty"`
+	Team          int32                  `protobuf:"varint,9,opt,name=team,proto3" json:"team,omitempty"`
+	SkinId        uint32                 `protobuf:"varint,10,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -454,6 +707,20 @@ func (x *PlayerMessage) GetColor() int32 {
 	return 0
 }
 
+func (x *PlayerMessage) GetTeam() int32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+func (x *PlayerMessage) GetSkinId() uint32 {
+	if x != nil {
+		return x.SkinId
+	}
+	return 0
+}
+
 type PlayerDirectionMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Direction     float64                `protobuf:"fixed64,1,opt,name=direction,proto3" json:"direction,omitempty"`
@@ -742,45 +1009,30 @@ func (x *DisconnectMessage) GetReason() string {
 	return ""
 }
 
-// Define the main Packet message
-type Packet struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	SenderId uint64                 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	// Types that are valid to be assigned to Msg:
-	//
-	//	*Packet_Chat
-	//	*Packet_Id
-	//	*Packet_LoginRequest
-	//	*Packet_GuestLoginRequest
-	//	*Packet_RegisterRequest
-	//	*Packet_OkResponse
-	//	*Packet_DenyResponse
-	//	*Packet_Player
-	//	*Packet_PlayerDirection
-	//	*Packet_Spore
-	//	*Packet_SporeConsumed
-	//	*Packet_SporesBatch
-	//	*Packet_PlayerConsumed
-	//	*Packet_Disconnect
-	Msg           isPacket_Msg `protobuf_oneof:"msg"`
+type TeamScoreMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          int32                  `protobuf:"varint,1,opt,name=team,proto3" json:"team,omitempty"`
+	Color         int32                  `protobuf:"varint,2,opt,name=color,proto3" json:"color,omitempty"`
+	Players       uint32                 `protobuf:"varint,3,opt,name=players,proto3" json:"players,omitempty"`
+	Mass          float64                `protobuf:"fixed64,4,opt,name=mass,proto3" json:"mass,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Packet) Reset() {
-	*x = Packet{}
+func (x *TeamScoreMessage) Reset() {
+	*x = TeamScoreMessage{}
 	mi := &file_packets_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Packet) String() string {
+func (x *TeamScoreMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Packet) ProtoMessage() {}
+func (*TeamScoreMessage) ProtoMessage() {}
 
-func (x *Packet) ProtoReflect() protoreflect.Message {
+func (x *TeamScoreMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_packets_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -792,146 +1044,2939 @@ func (x *Packet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Packet.ProtoReflect.Descriptor instead.
-func (*Packet) Descriptor() ([]byte, []int) {
+// Deprecated: Use TeamScoreMessage.ProtoReflect.Descriptor instead.
+func (*TeamScoreMessage) Descriptor() ([]byte, []int) {
 	return file_packets_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Packet) GetSenderId() uint64 {
+func (x *TeamScoreMessage) GetTeam() int32 {
 	if x != nil {
-		return x.SenderId
+		return x.Team
 	}
 	return 0
 }
 
-func (x *Packet) GetMsg() isPacket_Msg {
+func (x *TeamScoreMessage) GetColor() int32 {
 	if x != nil {
-		return x.Msg
+		return x.Color
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetChat() *ChatMessage {
+func (x *TeamScoreMessage) GetPlayers() uint32 {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Chat); ok {
-			return x.Chat
-		}
+		return x.Players
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetId() *IdMessage {
+func (x *TeamScoreMessage) GetMass() float64 {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Id); ok {
-			return x.Id
-		}
+		return x.Mass
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetLoginRequest() *LoginRequestMessage {
-	if x != nil {
-		if x, ok := x.Msg.(*Packet_LoginRequest); ok {
-			return x.LoginRequest
-		}
-	}
-	return nil
+type TeamScoreboardMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scores        []*TeamScoreMessage    `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Packet) GetGuestLoginRequest() *GuestLoginRequestMessage {
+func (x *TeamScoreboardMessage) Reset() {
+	*x = TeamScoreboardMessage{}
+	mi := &file_packets_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamScoreboardMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamScoreboardMessage) ProtoMessage() {}
+
+func (x *TeamScoreboardMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[15]
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_GuestLoginRequest); ok {
-			return x.GuestLoginRequest
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Packet) GetRegisterRequest() *RegisterRequestMessage {
+// Deprecated: Use TeamScoreboardMessage.ProtoReflect.Descriptor instead.
+func (*TeamScoreboardMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TeamScoreboardMessage) GetScores() []*TeamScoreMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_RegisterRequest); ok {
-			return x.RegisterRequest
-		}
+		return x.Scores
 	}
 	return nil
 }
 
-func (x *Packet) GetOkResponse() *OkResponseMessage {
+type RoundStateMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Round         uint32                 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Phase         RoundPhase             `protobuf:"varint,2,opt,name=phase,proto3,enum=packets.RoundPhase" json:"phase,omitempty"`
+	TimeRemaining float64                `protobuf:"fixed64,3,opt,name=time_remaining,json=timeRemaining,proto3" json:"time_remaining,omitempty"`
+	WinnerId      uint64                 `protobuf:"varint,4,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	WinnerName    string                 `protobuf:"bytes,5,opt,name=winner_name,json=winnerName,proto3" json:"winner_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoundStateMessage) Reset() {
+	*x = RoundStateMessage{}
+	mi := &file_packets_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundStateMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundStateMessage) ProtoMessage() {}
+
+func (x *RoundStateMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[16]
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_OkResponse); ok {
-			return x.OkResponse
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Packet) GetDenyResponse() *DenyResponseMessage {
+// Deprecated: Use RoundStateMessage.ProtoReflect.Descriptor instead.
+func (*RoundStateMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RoundStateMessage) GetRound() uint32 {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_DenyResponse); ok {
-			return x.DenyResponse
-		}
+		return x.Round
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetPlayer() *PlayerMessage {
+func (x *RoundStateMessage) GetPhase() RoundPhase {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Player); ok {
-			return x.Player
-		}
+		return x.Phase
 	}
-	return nil
+	return RoundPhase_ROUND_PHASE_NONE
 }
 
-func (x *Packet) GetPlayerDirection() *PlayerDirectionMessage {
+func (x *RoundStateMessage) GetTimeRemaining() float64 {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_PlayerDirection); ok {
-			return x.PlayerDirection
-		}
+		return x.TimeRemaining
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetSpore() *SporeMessage {
+func (x *RoundStateMessage) GetWinnerId() uint64 {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Spore); ok {
-			return x.Spore
-		}
+		return x.WinnerId
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetSporeConsumed() *SporeConsumedMessage {
+func (x *RoundStateMessage) GetWinnerName() string {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_SporeConsumed); ok {
-			return x.SporeConsumed
-		}
+		return x.WinnerName
 	}
-	return nil
+	return ""
+}
+
+type SafeZoneMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             float64                `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+	Radius        float64                `protobuf:"fixed64,3,opt,name=radius,proto3" json:"radius,omitempty"`
+	TargetRadius  float64                `protobuf:"fixed64,4,opt,name=target_radius,json=targetRadius,proto3" json:"target_radius,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SafeZoneMessage) Reset() {
+	*x = SafeZoneMessage{}
+	mi := &file_packets_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SafeZoneMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SafeZoneMessage) ProtoMessage() {}
+
+func (x *SafeZoneMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SafeZoneMessage.ProtoReflect.Descriptor instead.
+func (*SafeZoneMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SafeZoneMessage) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *SafeZoneMessage) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *SafeZoneMessage) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *SafeZoneMessage) GetTargetRadius() float64 {
+	if x != nil {
+		return x.TargetRadius
+	}
+	return 0
+}
+
+type WorldResetMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldResetMessage) Reset() {
+	*x = WorldResetMessage{}
+	mi := &file_packets_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldResetMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldResetMessage) ProtoMessage() {}
+
+func (x *WorldResetMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldResetMessage.ProtoReflect.Descriptor instead.
+func (*WorldResetMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{18}
+}
+
+type PowerUpMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	X             float64                `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
+	Radius        float64                `protobuf:"fixed64,4,opt,name=radius,proto3" json:"radius,omitempty"`
+	Kind          PowerUpKind            `protobuf:"varint,5,opt,name=kind,proto3,enum=packets.PowerUpKind" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowerUpMessage) Reset() {
+	*x = PowerUpMessage{}
+	mi := &file_packets_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerUpMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerUpMessage) ProtoMessage() {}
+
+func (x *PowerUpMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerUpMessage.ProtoReflect.Descriptor instead.
+func (*PowerUpMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PowerUpMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PowerUpMessage) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PowerUpMessage) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *PowerUpMessage) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *PowerUpMessage) GetKind() PowerUpKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PowerUpKind_POWER_UP_NONE
+}
+
+type PowerUpConsumedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PowerUpId     uint64                 `protobuf:"varint,1,opt,name=power_up_id,json=powerUpId,proto3" json:"power_up_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowerUpConsumedMessage) Reset() {
+	*x = PowerUpConsumedMessage{}
+	mi := &file_packets_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerUpConsumedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerUpConsumedMessage) ProtoMessage() {}
+
+func (x *PowerUpConsumedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerUpConsumedMessage.ProtoReflect.Descriptor instead.
+func (*PowerUpConsumedMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PowerUpConsumedMessage) GetPowerUpId() uint64 {
+	if x != nil {
+		return x.PowerUpId
+	}
+	return 0
+}
+
+type ActiveEffectMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          PowerUpKind            `protobuf:"varint,1,opt,name=kind,proto3,enum=packets.PowerUpKind" json:"kind,omitempty"`
+	TimeRemaining float64                `protobuf:"fixed64,2,opt,name=time_remaining,json=timeRemaining,proto3" json:"time_remaining,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActiveEffectMessage) Reset() {
+	*x = ActiveEffectMessage{}
+	mi := &file_packets_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActiveEffectMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActiveEffectMessage) ProtoMessage() {}
+
+func (x *ActiveEffectMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActiveEffectMessage.ProtoReflect.Descriptor instead.
+func (*ActiveEffectMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ActiveEffectMessage) GetKind() PowerUpKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PowerUpKind_POWER_UP_NONE
+}
+
+func (x *ActiveEffectMessage) GetTimeRemaining() float64 {
+	if x != nil {
+		return x.TimeRemaining
+	}
+	return 0
+}
+
+type PlayerEffectsMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      uint64                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Effects       []*ActiveEffectMessage `protobuf:"bytes,2,rep,name=effects,proto3" json:"effects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerEffectsMessage) Reset() {
+	*x = PlayerEffectsMessage{}
+	mi := &file_packets_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerEffectsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerEffectsMessage) ProtoMessage() {}
+
+func (x *PlayerEffectsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerEffectsMessage.ProtoReflect.Descriptor instead.
+func (*PlayerEffectsMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PlayerEffectsMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PlayerEffectsMessage) GetEffects() []*ActiveEffectMessage {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
+
+type DeathMessage struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	KillerId        uint64                 `protobuf:"varint,1,opt,name=killer_id,json=killerId,proto3" json:"killer_id,omitempty"`
+	KillerName      string                 `protobuf:"bytes,2,opt,name=killer_name,json=killerName,proto3" json:"killer_name,omitempty"`
+	FinalMass       float64                `protobuf:"fixed64,3,opt,name=final_mass,json=finalMass,proto3" json:"final_mass,omitempty"`
+	TimeAlive       float64                `protobuf:"fixed64,4,opt,name=time_alive,json=timeAlive,proto3" json:"time_alive,omitempty"`
+	Rank            uint32                 `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
+	RespawnCooldown float64                `protobuf:"fixed64,6,opt,name=respawn_cooldown,json=respawnCooldown,proto3" json:"respawn_cooldown,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeathMessage) Reset() {
+	*x = DeathMessage{}
+	mi := &file_packets_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeathMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeathMessage) ProtoMessage() {}
+
+func (x *DeathMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeathMessage.ProtoReflect.Descriptor instead.
+func (*DeathMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeathMessage) GetKillerId() uint64 {
+	if x != nil {
+		return x.KillerId
+	}
+	return 0
+}
+
+func (x *DeathMessage) GetKillerName() string {
+	if x != nil {
+		return x.KillerName
+	}
+	return ""
+}
+
+func (x *DeathMessage) GetFinalMass() float64 {
+	if x != nil {
+		return x.FinalMass
+	}
+	return 0
+}
+
+func (x *DeathMessage) GetTimeAlive() float64 {
+	if x != nil {
+		return x.TimeAlive
+	}
+	return 0
+}
+
+func (x *DeathMessage) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *DeathMessage) GetRespawnCooldown() float64 {
+	if x != nil {
+		return x.RespawnCooldown
+	}
+	return 0
+}
+
+type RespawnRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespawnRequestMessage) Reset() {
+	*x = RespawnRequestMessage{}
+	mi := &file_packets_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespawnRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespawnRequestMessage) ProtoMessage() {}
+
+func (x *RespawnRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespawnRequestMessage.ProtoReflect.Descriptor instead.
+func (*RespawnRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{24}
+}
+
+type ReportMessageMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      uint64                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportMessageMessage) Reset() {
+	*x = ReportMessageMessage{}
+	mi := &file_packets_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportMessageMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportMessageMessage) ProtoMessage() {}
+
+func (x *ReportMessageMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportMessageMessage.ProtoReflect.Descriptor instead.
+func (*ReportMessageMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReportMessageMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *ReportMessageMessage) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ReportMessageMessage) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ChatHistoryEntryMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SenderId      uint64                 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	SenderName    string                 `protobuf:"bytes,2,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	SentAt        int64                  `protobuf:"varint,4,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatHistoryEntryMessage) Reset() {
+	*x = ChatHistoryEntryMessage{}
+	mi := &file_packets_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatHistoryEntryMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatHistoryEntryMessage) ProtoMessage() {}
+
+func (x *ChatHistoryEntryMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatHistoryEntryMessage.ProtoReflect.Descriptor instead.
+func (*ChatHistoryEntryMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ChatHistoryEntryMessage) GetSenderId() uint64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *ChatHistoryEntryMessage) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *ChatHistoryEntryMessage) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ChatHistoryEntryMessage) GetSentAt() int64 {
+	if x != nil {
+		return x.SentAt
+	}
+	return 0
+}
+
+type ChatHistoryMessage struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Messages      []*ChatHistoryEntryMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatHistoryMessage) Reset() {
+	*x = ChatHistoryMessage{}
+	mi := &file_packets_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatHistoryMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatHistoryMessage) ProtoMessage() {}
+
+func (x *ChatHistoryMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatHistoryMessage.ProtoReflect.Descriptor instead.
+func (*ChatHistoryMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ChatHistoryMessage) GetMessages() []*ChatHistoryEntryMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type ChangePasswordRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	OldPassword   string                 `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequestMessage) Reset() {
+	*x = ChangePasswordRequestMessage{}
+	mi := &file_packets_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequestMessage) ProtoMessage() {}
+
+func (x *ChangePasswordRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequestMessage.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ChangePasswordRequestMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequestMessage) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequestMessage) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ResetPasswordRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	ResetCode     string                 `protobuf:"bytes,2,opt,name=reset_code,json=resetCode,proto3" json:"reset_code,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequestMessage) Reset() {
+	*x = ResetPasswordRequestMessage{}
+	mi := &file_packets_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequestMessage) ProtoMessage() {}
+
+func (x *ResetPasswordRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequestMessage.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ResetPasswordRequestMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequestMessage) GetResetCode() string {
+	if x != nil {
+		return x.ResetCode
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequestMessage) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type DeleteAccountRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequestMessage) Reset() {
+	*x = DeleteAccountRequestMessage{}
+	mi := &file_packets_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequestMessage) ProtoMessage() {}
+
+func (x *DeleteAccountRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequestMessage.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteAccountRequestMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *DeleteAccountRequestMessage) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ProfileMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Color         int32                  `protobuf:"varint,1,opt,name=color,proto3" json:"color,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	SkinId        uint32                 `protobuf:"varint,3,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
+	Settings      []byte                 `protobuf:"bytes,4,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileMessage) Reset() {
+	*x = ProfileMessage{}
+	mi := &file_packets_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileMessage) ProtoMessage() {}
+
+func (x *ProfileMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileMessage.ProtoReflect.Descriptor instead.
+func (*ProfileMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ProfileMessage) GetColor() int32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *ProfileMessage) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *ProfileMessage) GetSkinId() uint32 {
+	if x != nil {
+		return x.SkinId
+	}
+	return 0
+}
+
+func (x *ProfileMessage) GetSettings() []byte {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type GetProfileRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileRequestMessage) Reset() {
+	*x = GetProfileRequestMessage{}
+	mi := &file_packets_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequestMessage) ProtoMessage() {}
+
+func (x *GetProfileRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequestMessage.ProtoReflect.Descriptor instead.
+func (*GetProfileRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{32}
+}
+
+type HistoryRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryRequestMessage) Reset() {
+	*x = HistoryRequestMessage{}
+	mi := &file_packets_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryRequestMessage) ProtoMessage() {}
+
+func (x *HistoryRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryRequestMessage.ProtoReflect.Descriptor instead.
+func (*HistoryRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *HistoryRequestMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *HistoryRequestMessage) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *HistoryRequestMessage) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SessionMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerName    string                 `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	Room          string                 `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	StartedAt     int64                  `protobuf:"varint,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt       int64                  `protobuf:"varint,4,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	EndCause      string                 `protobuf:"bytes,5,opt,name=end_cause,json=endCause,proto3" json:"end_cause,omitempty"`
+	KillerName    string                 `protobuf:"bytes,6,opt,name=killer_name,json=killerName,proto3" json:"killer_name,omitempty"`
+	PeakMass      float64                `protobuf:"fixed64,7,opt,name=peak_mass,json=peakMass,proto3" json:"peak_mass,omitempty"`
+	SporesEaten   uint32                 `protobuf:"varint,8,opt,name=spores_eaten,json=sporesEaten,proto3" json:"spores_eaten,omitempty"`
+	PlayersEaten  uint32                 `protobuf:"varint,9,opt,name=players_eaten,json=playersEaten,proto3" json:"players_eaten,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionMessage) Reset() {
+	*x = SessionMessage{}
+	mi := &file_packets_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionMessage) ProtoMessage() {}
+
+func (x *SessionMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionMessage.ProtoReflect.Descriptor instead.
+func (*SessionMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SessionMessage) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *SessionMessage) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *SessionMessage) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *SessionMessage) GetEndedAt() int64 {
+	if x != nil {
+		return x.EndedAt
+	}
+	return 0
+}
+
+func (x *SessionMessage) GetEndCause() string {
+	if x != nil {
+		return x.EndCause
+	}
+	return ""
+}
+
+func (x *SessionMessage) GetKillerName() string {
+	if x != nil {
+		return x.KillerName
+	}
+	return ""
+}
+
+func (x *SessionMessage) GetPeakMass() float64 {
+	if x != nil {
+		return x.PeakMass
+	}
+	return 0
+}
+
+func (x *SessionMessage) GetSporesEaten() uint32 {
+	if x != nil {
+		return x.SporesEaten
+	}
+	return 0
+}
+
+func (x *SessionMessage) GetPlayersEaten() uint32 {
+	if x != nil {
+		return x.PlayersEaten
+	}
+	return 0
+}
+
+type HistoryMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*SessionMessage      `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryMessage) Reset() {
+	*x = HistoryMessage{}
+	mi := &file_packets_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryMessage) ProtoMessage() {}
+
+func (x *HistoryMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryMessage.ProtoReflect.Descriptor instead.
+func (*HistoryMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *HistoryMessage) GetSessions() []*SessionMessage {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type AchievementUnlockedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AchievementUnlockedMessage) Reset() {
+	*x = AchievementUnlockedMessage{}
+	mi := &file_packets_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AchievementUnlockedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AchievementUnlockedMessage) ProtoMessage() {}
+
+func (x *AchievementUnlockedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AchievementUnlockedMessage.ProtoReflect.Descriptor instead.
+func (*AchievementUnlockedMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AchievementUnlockedMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AchievementUnlockedMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AchievementUnlockedMessage) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type AddFriendRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFriendRequestMessage) Reset() {
+	*x = AddFriendRequestMessage{}
+	mi := &file_packets_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFriendRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFriendRequestMessage) ProtoMessage() {}
+
+func (x *AddFriendRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFriendRequestMessage.ProtoReflect.Descriptor instead.
+func (*AddFriendRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AddFriendRequestMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type RemoveFriendRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFriendRequestMessage) Reset() {
+	*x = RemoveFriendRequestMessage{}
+	mi := &file_packets_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFriendRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFriendRequestMessage) ProtoMessage() {}
+
+func (x *RemoveFriendRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFriendRequestMessage.ProtoReflect.Descriptor instead.
+func (*RemoveFriendRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RemoveFriendRequestMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type FriendListRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendListRequestMessage) Reset() {
+	*x = FriendListRequestMessage{}
+	mi := &file_packets_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendListRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendListRequestMessage) ProtoMessage() {}
+
+func (x *FriendListRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendListRequestMessage.ProtoReflect.Descriptor instead.
+func (*FriendListRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{39}
+}
+
+type FriendMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	State         FriendshipState        `protobuf:"varint,2,opt,name=state,proto3,enum=packets.FriendshipState" json:"state,omitempty"`
+	Online        bool                   `protobuf:"varint,3,opt,name=online,proto3" json:"online,omitempty"`
+	Room          string                 `protobuf:"bytes,4,opt,name=room,proto3" json:"room,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendMessage) Reset() {
+	*x = FriendMessage{}
+	mi := &file_packets_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendMessage) ProtoMessage() {}
+
+func (x *FriendMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendMessage.ProtoReflect.Descriptor instead.
+func (*FriendMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *FriendMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *FriendMessage) GetState() FriendshipState {
+	if x != nil {
+		return x.State
+	}
+	return FriendshipState_FRIENDSHIP_STATE_NONE
+}
+
+func (x *FriendMessage) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *FriendMessage) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *FriendMessage) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type FriendListMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Friends       []*FriendMessage       `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendListMessage) Reset() {
+	*x = FriendListMessage{}
+	mi := &file_packets_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendListMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendListMessage) ProtoMessage() {}
+
+func (x *FriendListMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendListMessage.ProtoReflect.Descriptor instead.
+func (*FriendListMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *FriendListMessage) GetFriends() []*FriendMessage {
+	if x != nil {
+		return x.Friends
+	}
+	return nil
+}
+
+type JoinFriendRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinFriendRequestMessage) Reset() {
+	*x = JoinFriendRequestMessage{}
+	mi := &file_packets_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinFriendRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinFriendRequestMessage) ProtoMessage() {}
+
+func (x *JoinFriendRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinFriendRequestMessage.ProtoReflect.Descriptor instead.
+func (*JoinFriendRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *JoinFriendRequestMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type PartyMemberMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyMemberMessage) Reset() {
+	*x = PartyMemberMessage{}
+	mi := &file_packets_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyMemberMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyMemberMessage) ProtoMessage() {}
+
+func (x *PartyMemberMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyMemberMessage.ProtoReflect.Descriptor instead.
+func (*PartyMemberMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PartyMemberMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PartyMemberMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PartyMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartyId       uint64                 `protobuf:"varint,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	LeaderId      uint64                 `protobuf:"varint,2,opt,name=leader_id,json=leaderId,proto3" json:"leader_id,omitempty"`
+	Members       []*PartyMemberMessage  `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	Room          string                 `protobuf:"bytes,4,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyMessage) Reset() {
+	*x = PartyMessage{}
+	mi := &file_packets_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyMessage) ProtoMessage() {}
+
+func (x *PartyMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyMessage.ProtoReflect.Descriptor instead.
+func (*PartyMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PartyMessage) GetPartyId() uint64 {
+	if x != nil {
+		return x.PartyId
+	}
+	return 0
+}
+
+func (x *PartyMessage) GetLeaderId() uint64 {
+	if x != nil {
+		return x.LeaderId
+	}
+	return 0
+}
+
+func (x *PartyMessage) GetMembers() []*PartyMemberMessage {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *PartyMessage) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+type PartyCreateRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyCreateRequestMessage) Reset() {
+	*x = PartyCreateRequestMessage{}
+	mi := &file_packets_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyCreateRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyCreateRequestMessage) ProtoMessage() {}
+
+func (x *PartyCreateRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyCreateRequestMessage.ProtoReflect.Descriptor instead.
+func (*PartyCreateRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{45}
+}
+
+type PartyInviteRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyInviteRequestMessage) Reset() {
+	*x = PartyInviteRequestMessage{}
+	mi := &file_packets_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyInviteRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyInviteRequestMessage) ProtoMessage() {}
+
+func (x *PartyInviteRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyInviteRequestMessage.ProtoReflect.Descriptor instead.
+func (*PartyInviteRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PartyInviteRequestMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PartyInviteMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartyId       uint64                 `protobuf:"varint,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	LeaderName    string                 `protobuf:"bytes,2,opt,name=leader_name,json=leaderName,proto3" json:"leader_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyInviteMessage) Reset() {
+	*x = PartyInviteMessage{}
+	mi := &file_packets_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyInviteMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyInviteMessage) ProtoMessage() {}
+
+func (x *PartyInviteMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyInviteMessage.ProtoReflect.Descriptor instead.
+func (*PartyInviteMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PartyInviteMessage) GetPartyId() uint64 {
+	if x != nil {
+		return x.PartyId
+	}
+	return 0
+}
+
+func (x *PartyInviteMessage) GetLeaderName() string {
+	if x != nil {
+		return x.LeaderName
+	}
+	return ""
+}
+
+type PartyAcceptRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartyId       uint64                 `protobuf:"varint,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyAcceptRequestMessage) Reset() {
+	*x = PartyAcceptRequestMessage{}
+	mi := &file_packets_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyAcceptRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyAcceptRequestMessage) ProtoMessage() {}
+
+func (x *PartyAcceptRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyAcceptRequestMessage.ProtoReflect.Descriptor instead.
+func (*PartyAcceptRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PartyAcceptRequestMessage) GetPartyId() uint64 {
+	if x != nil {
+		return x.PartyId
+	}
+	return 0
+}
+
+type PartyLeaveRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyLeaveRequestMessage) Reset() {
+	*x = PartyLeaveRequestMessage{}
+	mi := &file_packets_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyLeaveRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyLeaveRequestMessage) ProtoMessage() {}
+
+func (x *PartyLeaveRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyLeaveRequestMessage.ProtoReflect.Descriptor instead.
+func (*PartyLeaveRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{49}
+}
+
+type PartyKickRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      uint64                 `protobuf:"varint,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyKickRequestMessage) Reset() {
+	*x = PartyKickRequestMessage{}
+	mi := &file_packets_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyKickRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyKickRequestMessage) ProtoMessage() {}
+
+func (x *PartyKickRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyKickRequestMessage.ProtoReflect.Descriptor instead.
+func (*PartyKickRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PartyKickRequestMessage) GetMemberId() uint64 {
+	if x != nil {
+		return x.MemberId
+	}
+	return 0
+}
+
+type PartyRoomRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          string                 `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyRoomRequestMessage) Reset() {
+	*x = PartyRoomRequestMessage{}
+	mi := &file_packets_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyRoomRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyRoomRequestMessage) ProtoMessage() {}
+
+func (x *PartyRoomRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyRoomRequestMessage.ProtoReflect.Descriptor instead.
+func (*PartyRoomRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PartyRoomRequestMessage) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+type PlayerProfileRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerProfileRequestMessage) Reset() {
+	*x = PlayerProfileRequestMessage{}
+	mi := &file_packets_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerProfileRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerProfileRequestMessage) ProtoMessage() {}
+
+func (x *PlayerProfileRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerProfileRequestMessage.ProtoReflect.Descriptor instead.
+func (*PlayerProfileRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PlayerProfileRequestMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type RatingChangeMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rating        float64                `protobuf:"fixed64,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	Delta         float64                `protobuf:"fixed64,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	Placement     uint32                 `protobuf:"varint,3,opt,name=placement,proto3" json:"placement,omitempty"`
+	Players       uint32                 `protobuf:"varint,4,opt,name=players,proto3" json:"players,omitempty"`
+	Kills         uint32                 `protobuf:"varint,5,opt,name=kills,proto3" json:"kills,omitempty"`
+	ChangedAt     int64                  `protobuf:"varint,6,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingChangeMessage) Reset() {
+	*x = RatingChangeMessage{}
+	mi := &file_packets_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingChangeMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingChangeMessage) ProtoMessage() {}
+
+func (x *RatingChangeMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingChangeMessage.ProtoReflect.Descriptor instead.
+func (*RatingChangeMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RatingChangeMessage) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *RatingChangeMessage) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *RatingChangeMessage) GetPlacement() uint32 {
+	if x != nil {
+		return x.Placement
+	}
+	return 0
+}
+
+func (x *RatingChangeMessage) GetPlayers() uint32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+func (x *RatingChangeMessage) GetKills() uint32 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *RatingChangeMessage) GetChangedAt() int64 {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return 0
+}
+
+type PlayerProfileMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Rating        float64                `protobuf:"fixed64,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	Rank          uint32                 `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	History       []*RatingChangeMessage `protobuf:"bytes,4,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerProfileMessage) Reset() {
+	*x = PlayerProfileMessage{}
+	mi := &file_packets_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerProfileMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerProfileMessage) ProtoMessage() {}
+
+func (x *PlayerProfileMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerProfileMessage.ProtoReflect.Descriptor instead.
+func (*PlayerProfileMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PlayerProfileMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *PlayerProfileMessage) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *PlayerProfileMessage) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *PlayerProfileMessage) GetHistory() []*RatingChangeMessage {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type LeaderboardRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         uint32                 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardRequestMessage) Reset() {
+	*x = LeaderboardRequestMessage{}
+	mi := &file_packets_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardRequestMessage) ProtoMessage() {}
+
+func (x *LeaderboardRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardRequestMessage.ProtoReflect.Descriptor instead.
+func (*LeaderboardRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *LeaderboardRequestMessage) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type LeaderboardEntryMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          uint32                 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Rating        float64                `protobuf:"fixed64,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardEntryMessage) Reset() {
+	*x = LeaderboardEntryMessage{}
+	mi := &file_packets_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntryMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntryMessage) ProtoMessage() {}
+
+func (x *LeaderboardEntryMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntryMessage.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntryMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *LeaderboardEntryMessage) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntryMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LeaderboardEntryMessage) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+type LeaderboardMessage struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Entries       []*LeaderboardEntryMessage `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardMessage) Reset() {
+	*x = LeaderboardMessage{}
+	mi := &file_packets_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardMessage) ProtoMessage() {}
+
+func (x *LeaderboardMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardMessage.ProtoReflect.Descriptor instead.
+func (*LeaderboardMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *LeaderboardMessage) GetEntries() []*LeaderboardEntryMessage {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Define the main Packet message
+type Packet struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SenderId uint64                 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	// Types that are valid to be assigned to Msg:
+	//
+	//	*Packet_Chat
+	//	*Packet_Id
+	//	*Packet_LoginRequest
+	//	*Packet_GuestLoginRequest
+	//	*Packet_RegisterRequest
+	//	*Packet_OkResponse
+	//	*Packet_DenyResponse
+	//	*Packet_Player
+	//	*Packet_PlayerDirection
+	//	*Packet_Spore
+	//	*Packet_SporeConsumed
+	//	*Packet_SporesBatch
+	//	*Packet_PlayerConsumed
+	//	*Packet_Disconnect
+	//	*Packet_TeamScoreboard
+	//	*Packet_RoundState
+	//	*Packet_SafeZone
+	//	*Packet_PowerUp
+	//	*Packet_PowerUpConsumed
+	//	*Packet_PlayerEffects
+	//	*Packet_Death
+	//	*Packet_RespawnRequest
+	//	*Packet_ReportMessage
+	//	*Packet_ChatHistory
+	//	*Packet_ChangePasswordRequest
+	//	*Packet_ResetPasswordRequest
+	//	*Packet_DeleteAccountRequest
+	//	*Packet_Profile
+	//	*Packet_GetProfileRequest
+	//	*Packet_HistoryRequest
+	//	*Packet_History
+	//	*Packet_AchievementUnlocked
+	//	*Packet_AddFriendRequest
+	//	*Packet_RemoveFriendRequest
+	//	*Packet_FriendListRequest
+	//	*Packet_FriendList
+	//	*Packet_Friend
+	//	*Packet_JoinFriendRequest
+	//	*Packet_Party
+	//	*Packet_PartyCreateRequest
+	//	*Packet_PartyInviteRequest
+	//	*Packet_PartyInvite
+	//	*Packet_PartyAcceptRequest
+	//	*Packet_PartyLeaveRequest
+	//	*Packet_PartyKickRequest
+	//	*Packet_PartyRoomRequest
+	//	*Packet_PlayerProfileRequest
+	//	*Packet_PlayerProfile
+	//	*Packet_LeaderboardRequest
+	//	*Packet_Leaderboard
+	//	*Packet_WorldReset
+	Msg           isPacket_Msg `protobuf_oneof:"msg"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Packet) Reset() {
+	*x = Packet{}
+	mi := &file_packets_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Packet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Packet) ProtoMessage() {}
+
+func (x *Packet) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Packet.ProtoReflect.Descriptor instead.
+func (*Packet) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Packet) GetSenderId() uint64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *Packet) GetMsg() isPacket_Msg {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+func (x *Packet) GetChat() *ChatMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Chat); ok {
+			return x.Chat
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetId() *IdMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Id); ok {
+			return x.Id
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetLoginRequest() *LoginRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_LoginRequest); ok {
+			return x.LoginRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetGuestLoginRequest() *GuestLoginRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_GuestLoginRequest); ok {
+			return x.GuestLoginRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetRegisterRequest() *RegisterRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_RegisterRequest); ok {
+			return x.RegisterRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetOkResponse() *OkResponseMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_OkResponse); ok {
+			return x.OkResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetDenyResponse() *DenyResponseMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_DenyResponse); ok {
+			return x.DenyResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayer() *PlayerMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Player); ok {
+			return x.Player
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayerDirection() *PlayerDirectionMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PlayerDirection); ok {
+			return x.PlayerDirection
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSpore() *SporeMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Spore); ok {
+			return x.Spore
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSporeConsumed() *SporeConsumedMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SporeConsumed); ok {
+			return x.SporeConsumed
+		}
+	}
+	return nil
 }
 
 func (x *Packet) GetSporesBatch() *SporesBatchMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_SporesBatch); ok {
-			return x.SporesBatch
+		if x, ok := x.Msg.(*Packet_SporesBatch); ok {
+			return x.SporesBatch
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayerConsumed() *PlayerConsumedMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PlayerConsumed); ok {
+			return x.PlayerConsumed
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetDisconnect() *DisconnectMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Disconnect); ok {
+			return x.Disconnect
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetTeamScoreboard() *TeamScoreboardMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_TeamScoreboard); ok {
+			return x.TeamScoreboard
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetRoundState() *RoundStateMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_RoundState); ok {
+			return x.RoundState
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSafeZone() *SafeZoneMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SafeZone); ok {
+			return x.SafeZone
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPowerUp() *PowerUpMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PowerUp); ok {
+			return x.PowerUp
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPowerUpConsumed() *PowerUpConsumedMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PowerUpConsumed); ok {
+			return x.PowerUpConsumed
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayerEffects() *PlayerEffectsMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PlayerEffects); ok {
+			return x.PlayerEffects
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetDeath() *DeathMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Death); ok {
+			return x.Death
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetRespawnRequest() *RespawnRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_RespawnRequest); ok {
+			return x.RespawnRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetReportMessage() *ReportMessageMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ReportMessage); ok {
+			return x.ReportMessage
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetChatHistory() *ChatHistoryMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ChatHistory); ok {
+			return x.ChatHistory
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetChangePasswordRequest() *ChangePasswordRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ChangePasswordRequest); ok {
+			return x.ChangePasswordRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetResetPasswordRequest() *ResetPasswordRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ResetPasswordRequest); ok {
+			return x.ResetPasswordRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetDeleteAccountRequest() *DeleteAccountRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_DeleteAccountRequest); ok {
+			return x.DeleteAccountRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetProfile() *ProfileMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Profile); ok {
+			return x.Profile
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetGetProfileRequest() *GetProfileRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_GetProfileRequest); ok {
+			return x.GetProfileRequest
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetPlayerConsumed() *PlayerConsumedMessage {
+func (x *Packet) GetHistoryRequest() *HistoryRequestMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_PlayerConsumed); ok {
-			return x.PlayerConsumed
+		if x, ok := x.Msg.(*Packet_HistoryRequest); ok {
+			return x.HistoryRequest
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetDisconnect() *DisconnectMessage {
+func (x *Packet) GetHistory() *HistoryMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Disconnect); ok {
-			return x.Disconnect
+		if x, ok := x.Msg.(*Packet_History); ok {
+			return x.History
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAchievementUnlocked() *AchievementUnlockedMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AchievementUnlocked); ok {
+			return x.AchievementUnlocked
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAddFriendRequest() *AddFriendRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_AddFriendRequest); ok {
+			return x.AddFriendRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetRemoveFriendRequest() *RemoveFriendRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_RemoveFriendRequest); ok {
+			return x.RemoveFriendRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetFriendListRequest() *FriendListRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_FriendListRequest); ok {
+			return x.FriendListRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetFriendList() *FriendListMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_FriendList); ok {
+			return x.FriendList
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetFriend() *FriendMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Friend); ok {
+			return x.Friend
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetJoinFriendRequest() *JoinFriendRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_JoinFriendRequest); ok {
+			return x.JoinFriendRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetParty() *PartyMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Party); ok {
+			return x.Party
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPartyCreateRequest() *PartyCreateRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PartyCreateRequest); ok {
+			return x.PartyCreateRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPartyInviteRequest() *PartyInviteRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PartyInviteRequest); ok {
+			return x.PartyInviteRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPartyInvite() *PartyInviteMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PartyInvite); ok {
+			return x.PartyInvite
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPartyAcceptRequest() *PartyAcceptRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PartyAcceptRequest); ok {
+			return x.PartyAcceptRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPartyLeaveRequest() *PartyLeaveRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PartyLeaveRequest); ok {
+			return x.PartyLeaveRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPartyKickRequest() *PartyKickRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PartyKickRequest); ok {
+			return x.PartyKickRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPartyRoomRequest() *PartyRoomRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PartyRoomRequest); ok {
+			return x.PartyRoomRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayerProfileRequest() *PlayerProfileRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PlayerProfileRequest); ok {
+			return x.PlayerProfileRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayerProfile() *PlayerProfileMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PlayerProfile); ok {
+			return x.PlayerProfile
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetLeaderboardRequest() *LeaderboardRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_LeaderboardRequest); ok {
+			return x.LeaderboardRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetLeaderboard() *LeaderboardMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Leaderboard); ok {
+			return x.Leaderboard
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetWorldReset() *WorldResetMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_WorldReset); ok {
+			return x.WorldReset
 		}
 	}
 	return nil
@@ -997,6 +4042,154 @@ type Packet_Disconnect struct {
 	Disconnect *DisconnectMessage `protobuf:"bytes,15,opt,name=disconnect,proto3,oneof"`
 }
 
+type Packet_TeamScoreboard struct {
+	TeamScoreboard *TeamScoreboardMessage `protobuf:"bytes,16,opt,name=team_scoreboard,json=teamScoreboard,proto3,oneof"`
+}
+
+type Packet_RoundState struct {
+	RoundState *RoundStateMessage `protobuf:"bytes,17,opt,name=round_state,json=roundState,proto3,oneof"`
+}
+
+type Packet_SafeZone struct {
+	SafeZone *SafeZoneMessage `protobuf:"bytes,18,opt,name=safe_zone,json=safeZone,proto3,oneof"`
+}
+
+type Packet_PowerUp struct {
+	PowerUp *PowerUpMessage `protobuf:"bytes,19,opt,name=power_up,json=powerUp,proto3,oneof"`
+}
+
+type Packet_PowerUpConsumed struct {
+	PowerUpConsumed *PowerUpConsumedMessage `protobuf:"bytes,20,opt,name=power_up_consumed,json=powerUpConsumed,proto3,oneof"`
+}
+
+type Packet_PlayerEffects struct {
+	PlayerEffects *PlayerEffectsMessage `protobuf:"bytes,21,opt,name=player_effects,json=playerEffects,proto3,oneof"`
+}
+
+type Packet_Death struct {
+	Death *DeathMessage `protobuf:"bytes,22,opt,name=death,proto3,oneof"`
+}
+
+type Packet_RespawnRequest struct {
+	RespawnRequest *RespawnRequestMessage `protobuf:"bytes,23,opt,name=respawn_request,json=respawnRequest,proto3,oneof"`
+}
+
+type Packet_ReportMessage struct {
+	ReportMessage *ReportMessageMessage `protobuf:"bytes,24,opt,name=report_message,json=reportMessage,proto3,oneof"`
+}
+
+type Packet_ChatHistory struct {
+	ChatHistory *ChatHistoryMessage `protobuf:"bytes,25,opt,name=chat_history,json=chatHistory,proto3,oneof"`
+}
+
+type Packet_ChangePasswordRequest struct {
+	ChangePasswordRequest *ChangePasswordRequestMessage `protobuf:"bytes,26,opt,name=change_password_request,json=changePasswordRequest,proto3,oneof"`
+}
+
+type Packet_ResetPasswordRequest struct {
+	ResetPasswordRequest *ResetPasswordRequestMessage `protobuf:"bytes,27,opt,name=reset_password_request,json=resetPasswordRequest,proto3,oneof"`
+}
+
+type Packet_DeleteAccountRequest struct {
+	DeleteAccountRequest *DeleteAccountRequestMessage `protobuf:"bytes,28,opt,name=delete_account_request,json=deleteAccountRequest,proto3,oneof"`
+}
+
+type Packet_Profile struct {
+	Profile *ProfileMessage `protobuf:"bytes,29,opt,name=profile,proto3,oneof"`
+}
+
+type Packet_GetProfileRequest struct {
+	GetProfileRequest *GetProfileRequestMessage `protobuf:"bytes,30,opt,name=get_profile_request,json=getProfileRequest,proto3,oneof"`
+}
+
+type Packet_HistoryRequest struct {
+	HistoryRequest *HistoryRequestMessage `protobuf:"bytes,31,opt,name=history_request,json=historyRequest,proto3,oneof"`
+}
+
+type Packet_History struct {
+	History *HistoryMessage `protobuf:"bytes,32,opt,name=history,proto3,oneof"`
+}
+
+type Packet_AchievementUnlocked struct {
+	AchievementUnlocked *AchievementUnlockedMessage `protobuf:"bytes,33,opt,name=achievement_unlocked,json=achievementUnlocked,proto3,oneof"`
+}
+
+type Packet_AddFriendRequest struct {
+	AddFriendRequest *AddFriendRequestMessage `protobuf:"bytes,34,opt,name=add_friend_request,json=addFriendRequest,proto3,oneof"`
+}
+
+type Packet_RemoveFriendRequest struct {
+	RemoveFriendRequest *RemoveFriendRequestMessage `protobuf:"bytes,35,opt,name=remove_friend_request,json=removeFriendRequest,proto3,oneof"`
+}
+
+type Packet_FriendListRequest struct {
+	FriendListRequest *FriendListRequestMessage `protobuf:"bytes,36,opt,name=friend_list_request,json=friendListRequest,proto3,oneof"`
+}
+
+type Packet_FriendList struct {
+	FriendList *FriendListMessage `protobuf:"bytes,37,opt,name=friend_list,json=friendList,proto3,oneof"`
+}
+
+type Packet_Friend struct {
+	Friend *FriendMessage `protobuf:"bytes,38,opt,name=friend,proto3,oneof"`
+}
+
+type Packet_JoinFriendRequest struct {
+	JoinFriendRequest *JoinFriendRequestMessage `protobuf:"bytes,39,opt,name=join_friend_request,json=joinFriendRequest,proto3,oneof"`
+}
+
+type Packet_Party struct {
+	Party *PartyMessage `protobuf:"bytes,40,opt,name=party,proto3,oneof"`
+}
+
+type Packet_PartyCreateRequest struct {
+	PartyCreateRequest *PartyCreateRequestMessage `protobuf:"bytes,41,opt,name=party_create_request,json=partyCreateRequest,proto3,oneof"`
+}
+
+type Packet_PartyInviteRequest struct {
+	PartyInviteRequest *PartyInviteRequestMessage `protobuf:"bytes,42,opt,name=party_invite_request,json=partyInviteRequest,proto3,oneof"`
+}
+
+type Packet_PartyInvite struct {
+	PartyInvite *PartyInviteMessage `protobuf:"bytes,43,opt,name=party_invite,json=partyInvite,proto3,oneof"`
+}
+
+type Packet_PartyAcceptRequest struct {
+	PartyAcceptRequest *PartyAcceptRequestMessage `protobuf:"bytes,44,opt,name=party_accept_request,json=partyAcceptRequest,proto3,oneof"`
+}
+
+type Packet_PartyLeaveRequest struct {
+	PartyLeaveRequest *PartyLeaveRequestMessage `protobuf:"bytes,45,opt,name=party_leave_request,json=partyLeaveRequest,proto3,oneof"`
+}
+
+type Packet_PartyKickRequest struct {
+	PartyKickRequest *PartyKickRequestMessage `protobuf:"bytes,46,opt,name=party_kick_request,json=partyKickRequest,proto3,oneof"`
+}
+
+type Packet_PartyRoomRequest struct {
+	PartyRoomRequest *PartyRoomRequestMessage `protobuf:"bytes,47,opt,name=party_room_request,json=partyRoomRequest,proto3,oneof"`
+}
+
+type Packet_PlayerProfileRequest struct {
+	PlayerProfileRequest *PlayerProfileRequestMessage `protobuf:"bytes,48,opt,name=player_profile_request,json=playerProfileRequest,proto3,oneof"`
+}
+
+type Packet_PlayerProfile struct {
+	PlayerProfile *PlayerProfileMessage `protobuf:"bytes,49,opt,name=player_profile,json=playerProfile,proto3,oneof"`
+}
+
+type Packet_LeaderboardRequest struct {
+	LeaderboardRequest *LeaderboardRequestMessage `protobuf:"bytes,50,opt,name=leaderboard_request,json=leaderboardRequest,proto3,oneof"`
+}
+
+type Packet_Leaderboard struct {
+	Leaderboard *LeaderboardMessage `protobuf:"bytes,51,opt,name=leaderboard,proto3,oneof"`
+}
+
+type Packet_WorldReset struct {
+	WorldReset *WorldResetMessage `protobuf:"bytes,52,opt,name=world_reset,json=worldReset,proto3,oneof"`
+}
+
 func (*Packet_Chat) isPacket_Msg() {}
 
 func (*Packet_Id) isPacket_Msg() {}
@@ -1025,47 +4218,133 @@ func (*Packet_PlayerConsumed) isPacket_Msg() {}
 
 func (*Packet_Disconnect) isPacket_Msg() {}
 
+func (*Packet_TeamScoreboard) isPacket_Msg() {}
+
+func (*Packet_RoundState) isPacket_Msg() {}
+
+func (*Packet_SafeZone) isPacket_Msg() {}
+
+func (*Packet_PowerUp) isPacket_Msg() {}
+
+func (*Packet_PowerUpConsumed) isPacket_Msg() {}
+
+func (*Packet_PlayerEffects) isPacket_Msg() {}
+
+func (*Packet_Death) isPacket_Msg() {}
+
+func (*Packet_RespawnRequest) isPacket_Msg() {}
+
+func (*Packet_ReportMessage) isPacket_Msg() {}
+
+func (*Packet_ChatHistory) isPacket_Msg() {}
+
+func (*Packet_ChangePasswordRequest) isPacket_Msg() {}
+
+func (*Packet_ResetPasswordRequest) isPacket_Msg() {}
+
+func (*Packet_DeleteAccountRequest) isPacket_Msg() {}
+
+func (*Packet_Profile) isPacket_Msg() {}
+
+func (*Packet_GetProfileRequest) isPacket_Msg() {}
+
+func (*Packet_HistoryRequest) isPacket_Msg() {}
+
+func (*Packet_History) isPacket_Msg() {}
+
+func (*Packet_AchievementUnlocked) isPacket_Msg() {}
+
+func (*Packet_AddFriendRequest) isPacket_Msg() {}
+
+func (*Packet_RemoveFriendRequest) isPacket_Msg() {}
+
+func (*Packet_FriendListRequest) isPacket_Msg() {}
+
+func (*Packet_FriendList) isPacket_Msg() {}
+
+func (*Packet_Friend) isPacket_Msg() {}
+
+func (*Packet_JoinFriendRequest) isPacket_Msg() {}
+
+func (*Packet_Party) isPacket_Msg() {}
+
+func (*Packet_PartyCreateRequest) isPacket_Msg() {}
+
+func (*Packet_PartyInviteRequest) isPacket_Msg() {}
+
+func (*Packet_PartyInvite) isPacket_Msg() {}
+
+func (*Packet_PartyAcceptRequest) isPacket_Msg() {}
+
+func (*Packet_PartyLeaveRequest) isPacket_Msg() {}
+
+func (*Packet_PartyKickRequest) isPacket_Msg() {}
+
+func (*Packet_PartyRoomRequest) isPacket_Msg() {}
+
+func (*Packet_PlayerProfileRequest) isPacket_Msg() {}
+
+func (*Packet_PlayerProfile) isPacket_Msg() {}
+
+func (*Packet_LeaderboardRequest) isPacket_Msg() {}
+
+func (*Packet_Leaderboard) isPacket_Msg() {}
+
+func (*Packet_WorldReset) isPacket_Msg() {}
+
 var File_packets_proto protoreflect.FileDescriptor
 
 var file_packets_proto_rawDesc = string([]byte{
 	0x0a, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x1f, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x1b, 0x0a, 0x09, 0x49, 0x64, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x63, 0x0a, 0x13, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x4c, 0x0a, 0x18, 0x47,
-	0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x50, 0x0a, 0x16, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x4f,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x2d, 0x0a, 0x13, 0x44, 0x65, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
-	0xb1, 0x01, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01,
-	0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
-	0x6c, 0x6f, 0x72, 0x22, 0x36, 0x0a, 0x16, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x72,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2b, 0x0a, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0x1b, 0x0a, 0x09,
+	0x49, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7b, 0x0a, 0x13, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0x64, 0x0a, 0x18, 0x47, 0x75, 0x65, 0x73, 0x74, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x16,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x13,
+	0x0a, 0x11, 0x4f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x2d, 0x0a, 0x13, 0x44, 0x65, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0xde, 0x01, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x01, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6b,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x6b, 0x69,
+	0x6e, 0x49, 0x64, 0x22, 0x36, 0x0a, 0x16, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x72,
 	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a,
 	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
 	0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x0c, 0x53,
@@ -1087,67 +4366,528 @@ var file_packets_proto_rawDesc = string([]byte{
 	0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2b, 0x0a,
 	0x11, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
 	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xac, 0x07, 0x0a, 0x06, 0x50,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12, 0x24,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x49, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x6f, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x13, 0x67, 0x75, 0x65,
-	0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x11, 0x67, 0x75, 0x65,
-	0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c,
-	0x0a, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b,
-	0x6f, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4f, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
-	0x0a, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x64,
-	0x65, 0x6e, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x6e,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x30, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
-	0x0f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x2d, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x12,
-	0x46, 0x0a, 0x0e, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
-	0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x43,
-	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0c, 0x73, 0x70, 0x6f, 0x72, 0x65,
-	0x73, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x70,
-	0x6f, 0x72, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x49, 0x0a, 0x0f, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73,
-	0x75, 0x6d, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x42, 0x0d, 0x5a, 0x0b, 0x70, 0x6b, 0x67,
-	0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x6a, 0x0a, 0x10, 0x54, 0x65,
+	0x61, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x65,
+	0x61, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x22, 0x4a, 0x0a, 0x15, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x31, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x22, 0xb9, 0x01, 0x0a, 0x11, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x29,
+	0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x68, 0x61,
+	0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x6a,
+	0x0a, 0x0f, 0x53, 0x61, 0x66, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12,
+	0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72,
+	0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x57, 0x6f,
+	0x72, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x7e, 0x0a, 0x0e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12,
+	0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72,
+	0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6f,
+	0x77, 0x65, 0x72, 0x55, 0x70, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22,
+	0x38, 0x0a, 0x16, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x70, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x5f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x70, 0x49, 0x64, 0x22, 0x66, 0x0a, 0x13, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x28, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x70,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x22, 0x6b, 0x0a, 0x14, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x07, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x22, 0xc9,
+	0x01, 0x0a, 0x0c, 0x44, 0x65, 0x61, 0x74, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x4d, 0x61, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12,
+	0x29, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x63, 0x6f, 0x6f, 0x6c, 0x64,
+	0x6f, 0x77, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x43, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x82, 0x01, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17,
+	0x0a, 0x07, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x73, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x22, 0x52, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x74, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a,
+	0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x1c,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x5f,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e,
+	0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x7b,
+	0x0a, 0x1b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x55, 0x0a, 0x1b, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x22, 0x7e, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x73, 0x6b, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x73, 0x6b, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x65,
+	0x0a, 0x15, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xa2, 0x02, 0x0a, 0x0e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x63,
+	0x61, 0x75, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x43,
+	0x61, 0x75, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x69, 0x6c, 0x6c, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x6d, 0x61,
+	0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x65, 0x61, 0x6b, 0x4d, 0x61,
+	0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x5f, 0x65, 0x61, 0x74,
+	0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x73,
+	0x45, 0x61, 0x74, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x5f, 0x65, 0x61, 0x74, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x45, 0x61, 0x74, 0x65, 0x6e, 0x22, 0x45, 0x0a, 0x0e, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x08,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x62, 0x0a, 0x1a, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x35, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x38, 0x0a, 0x1a,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x73, 0x68, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x45, 0x0a, 0x11, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x66, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x22, 0x36, 0x0a, 0x18, 0x4a,
+	0x6f, 0x69, 0x6e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x38, 0x0a, 0x12, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x91, 0x01,
+	0x0a, 0x0c, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f,
+	0x6d, 0x22, 0x1b, 0x0a, 0x19, 0x50, 0x61, 0x72, 0x74, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2f,
+	0x0a, 0x19, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x50, 0x0a, 0x12, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x36, 0x0a, 0x19, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x50, 0x61, 0x72,
+	0x74, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x36, 0x0a, 0x17, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4b, 0x69,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2d, 0x0a,
+	0x17, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x22, 0x39, 0x0a, 0x1b,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x13, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x14, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x36, 0x0a, 0x07, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x22, 0x31, 0x0a, 0x19, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x61, 0x0a, 0x17, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x50, 0x0a, 0x12, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x3a, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xf8, 0x1c, 0x0a, 0x06,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12,
+	0x24, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x49, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
+	0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x13, 0x67, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x11, 0x67, 0x75,
+	0x65, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4c, 0x0a, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a,
+	0x0b, 0x6f, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4f, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x0a, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0d,
+	0x64, 0x65, 0x6e, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x44, 0x65,
+	0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x0f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x65,
+	0x12, 0x46, 0x0a, 0x0e, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x70, 0x6f, 0x72, 0x65,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0c, 0x73, 0x70, 0x6f, 0x72,
+	0x65, 0x73, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x73,
+	0x70, 0x6f, 0x72, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x49, 0x0a, 0x0f, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x12, 0x49, 0x0a, 0x0f, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0e,
+	0x74, 0x65, 0x61, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x3d,
+	0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
+	0x00, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a,
+	0x09, 0x73, 0x61, 0x66, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x61, 0x66, 0x65, 0x5a,
+	0x6f, 0x6e, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x08, 0x73, 0x61,
+	0x66, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f,
+	0x75, 0x70, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x07, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x70, 0x12, 0x4d, 0x0a, 0x11,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x70, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x55, 0x70, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x64, 0x65, 0x61, 0x74, 0x68, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x61,
+	0x74, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x64, 0x65, 0x61,
+	0x74, 0x68, 0x12, 0x49, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x72,
+	0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a,
+	0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x5f, 0x0a, 0x17, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
+	0x00, 0x52, 0x15, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5c, 0x0a, 0x16, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x14, 0x72, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5c, 0x0a, 0x16, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x14,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x53, 0x0a, 0x13, 0x67, 0x65, 0x74,
+	0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x11, 0x67, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49,
+	0x0a, 0x0f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x58,
+	0x0a, 0x14, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x13, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x50, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x5f,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x22,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x10, 0x61, 0x64, 0x64, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x59, 0x0a, 0x15, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x13, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x24, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x11, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x66, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x66,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x66, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x06, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x53, 0x0a, 0x13, 0x6a,
+	0x6f, 0x69, 0x6e, 0x5f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x11, 0x6a,
+	0x6f, 0x69, 0x6e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2d, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12,
+	0x56, 0x0a, 0x14, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x12, 0x70, 0x61, 0x72, 0x74, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x14, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x12, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x40, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18,
+	0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x12, 0x56, 0x0a, 0x14, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x12, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x13, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x2d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x11, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x50,
+	0x0a, 0x12, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x6b, 0x69, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4b, 0x69, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x10,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x4b, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x50, 0x0a, 0x12, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x10, 0x70, 0x61, 0x72, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x5c, 0x0a, 0x16, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x30, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x14, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x46, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x55, 0x0a, 0x13, 0x6c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x12, 0x6c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3f, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x33,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x12, 0x3d, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18,
+	0x34, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x57, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x42,
+	0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x2a, 0x69, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50,
+	0x68, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x50, 0x48,
+	0x41, 0x53, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x4f,
+	0x55, 0x4e, 0x44, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x10,
+	0x01, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45,
+	0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x4f,
+	0x55, 0x4e, 0x44, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10,
+	0x03, 0x2a, 0x68, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
+	0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x47,
+	0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x48, 0x41, 0x54, 0x5f,
+	0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x54, 0x45, 0x41, 0x4d, 0x10, 0x01, 0x12, 0x14, 0x0a,
+	0x10, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x52, 0x4f, 0x4f,
+	0x4d, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47,
+	0x45, 0x54, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0x03, 0x2a, 0x7c, 0x0a, 0x0b, 0x50,
+	0x6f, 0x77, 0x65, 0x72, 0x55, 0x70, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x4f,
+	0x57, 0x45, 0x52, 0x5f, 0x55, 0x50, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a,
+	0x0e, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x55, 0x50, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x55, 0x50, 0x5f, 0x53, 0x48,
+	0x49, 0x45, 0x4c, 0x44, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f,
+	0x55, 0x50, 0x5f, 0x4d, 0x41, 0x47, 0x4e, 0x45, 0x54, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x50,
+	0x4f, 0x57, 0x45, 0x52, 0x5f, 0x55, 0x50, 0x5f, 0x4d, 0x41, 0x53, 0x53, 0x5f, 0x4d, 0x55, 0x4c,
+	0x54, 0x49, 0x50, 0x4c, 0x49, 0x45, 0x52, 0x10, 0x04, 0x2a, 0x94, 0x01, 0x0a, 0x0f, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a,
+	0x15, 0x46, 0x52, 0x49, 0x45, 0x4e, 0x44, 0x53, 0x48, 0x49, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x46, 0x52, 0x49, 0x45,
+	0x4e, 0x44, 0x53, 0x48, 0x49, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x52, 0x49,
+	0x45, 0x4e, 0x44, 0x53, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x46, 0x52, 0x49, 0x45, 0x4e, 0x44,
+	0x53, 0x48, 0x49, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
+	0x53, 0x54, 0x5f, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x46, 0x52, 0x49,
+	0x45, 0x4e, 0x44, 0x53, 0x48, 0x49, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x10, 0x03,
+	0x42, 0x0d, 0x5a, 0x0b, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -1162,45 +4902,144 @@ func file_packets_proto_rawDescGZIP() []byte {
 	return file_packets_proto_rawDescData
 }
 
-var file_packets_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_packets_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_packets_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_packets_proto_goTypes = []any{
-	(*ChatMessage)(nil),              // 0: packets.ChatMessage
-	(*IdMessage)(nil),                // 1: packets.IdMessage
-	(*LoginRequestMessage)(nil),      // 2: packets.LoginRequestMessage
-	(*GuestLoginRequestMessage)(nil), // 3: packets.GuestLoginRequestMessage
-	(*RegisterRequestMessage)(nil),   // 4: packets.RegisterRequestMessage
-	(*OkResponseMessage)(nil),        // 5: packets.OkResponseMessage
-	(*DenyResponseMessage)(nil),      // 6: packets.DenyResponseMessage
-	(*PlayerMessage)(nil),            // 7: packets.PlayerMessage
-	(*PlayerDirectionMessage)(nil),   // 8: packets.PlayerDirectionMessage
-	(*SporeMessage)(nil),             // 9: packets.SporeMessage
-	(*SporeConsumedMessage)(nil),     // 10: packets.SporeConsumedMessage
-	(*SporesBatchMessage)(nil),       // 11: packets.SporesBatchMessage
-	(*PlayerConsumedMessage)(nil),    // 12: packets.PlayerConsumedMessage
-	(*DisconnectMessage)(nil),        // 13: packets.DisconnectMessage
-	(*Packet)(nil),                   // 14: packets.Packet
+	(RoundPhase)(0),                      // 0: packets.RoundPhase
+	(ChatTarget)(0),                      // 1: packets.ChatTarget
+	(PowerUpKind)(0),                     // 2: packets.PowerUpKind
+	(FriendshipState)(0),                 // 3: packets.FriendshipState
+	(*ChatMessage)(nil),                  // 4: packets.ChatMessage
+	(*IdMessage)(nil),                    // 5: packets.IdMessage
+	(*LoginRequestMessage)(nil),          // 6: packets.LoginRequestMessage
+	(*GuestLoginRequestMessage)(nil),     // 7: packets.GuestLoginRequestMessage
+	(*RegisterRequestMessage)(nil),       // 8: packets.RegisterRequestMessage
+	(*OkResponseMessage)(nil),            // 9: packets.OkResponseMessage
+	(*DenyResponseMessage)(nil),          // 10: packets.DenyResponseMessage
+	(*PlayerMessage)(nil),                // 11: packets.PlayerMessage
+	(*PlayerDirectionMessage)(nil),       // 12: packets.PlayerDirectionMessage
+	(*SporeMessage)(nil),                 // 13: packets.SporeMessage
+	(*SporeConsumedMessage)(nil),         // 14: packets.SporeConsumedMessage
+	(*SporesBatchMessage)(nil),           // 15: packets.SporesBatchMessage
+	(*PlayerConsumedMessage)(nil),        // 16: packets.PlayerConsumedMessage
+	(*DisconnectMessage)(nil),            // 17: packets.DisconnectMessage
+	(*TeamScoreMessage)(nil),             // 18: packets.TeamScoreMessage
+	(*TeamScoreboardMessage)(nil),        // 19: packets.TeamScoreboardMessage
+	(*RoundStateMessage)(nil),            // 20: packets.RoundStateMessage
+	(*SafeZoneMessage)(nil),              // 21: packets.SafeZoneMessage
+	(*WorldResetMessage)(nil),            // 22: packets.WorldResetMessage
+	(*PowerUpMessage)(nil),               // 23: packets.PowerUpMessage
+	(*PowerUpConsumedMessage)(nil),       // 24: packets.PowerUpConsumedMessage
+	(*ActiveEffectMessage)(nil),          // 25: packets.ActiveEffectMessage
+	(*PlayerEffectsMessage)(nil),         // 26: packets.PlayerEffectsMessage
+	(*DeathMessage)(nil),                 // 27: packets.DeathMessage
+	(*RespawnRequestMessage)(nil),        // 28: packets.RespawnRequestMessage
+	(*ReportMessageMessage)(nil),         // 29: packets.ReportMessageMessage
+	(*ChatHistoryEntryMessage)(nil),      // 30: packets.ChatHistoryEntryMessage
+	(*ChatHistoryMessage)(nil),           // 31: packets.ChatHistoryMessage
+	(*ChangePasswordRequestMessage)(nil), // 32: packets.ChangePasswordRequestMessage
+	(*ResetPasswordRequestMessage)(nil),  // 33: packets.ResetPasswordRequestMessage
+	(*DeleteAccountRequestMessage)(nil),  // 34: packets.DeleteAccountRequestMessage
+	(*ProfileMessage)(nil),               // 35: packets.ProfileMessage
+	(*GetProfileRequestMessage)(nil),     // 36: packets.GetProfileRequestMessage
+	(*HistoryRequestMessage)(nil),        // 37: packets.HistoryRequestMessage
+	(*SessionMessage)(nil),               // 38: packets.SessionMessage
+	(*HistoryMessage)(nil),               // 39: packets.HistoryMessage
+	(*AchievementUnlockedMessage)(nil),   // 40: packets.AchievementUnlockedMessage
+	(*AddFriendRequestMessage)(nil),      // 41: packets.AddFriendRequestMessage
+	(*RemoveFriendRequestMessage)(nil),   // 42: packets.RemoveFriendRequestMessage
+	(*FriendListRequestMessage)(nil),     // 43: packets.FriendListRequestMessage
+	(*FriendMessage)(nil),                // 44: packets.FriendMessage
+	(*FriendListMessage)(nil),            // 45: packets.FriendListMessage
+	(*JoinFriendRequestMessage)(nil),     // 46: packets.JoinFriendRequestMessage
+	(*PartyMemberMessage)(nil),           // 47: packets.PartyMemberMessage
+	(*PartyMessage)(nil),                 // 48: packets.PartyMessage
+	(*PartyCreateRequestMessage)(nil),    // 49: packets.PartyCreateRequestMessage
+	(*PartyInviteRequestMessage)(nil),    // 50: packets.PartyInviteRequestMessage
+	(*PartyInviteMessage)(nil),           // 51: packets.PartyInviteMessage
+	(*PartyAcceptRequestMessage)(nil),    // 52: packets.PartyAcceptRequestMessage
+	(*PartyLeaveRequestMessage)(nil),     // 53: packets.PartyLeaveRequestMessage
+	(*PartyKickRequestMessage)(nil),      // 54: packets.PartyKickRequestMessage
+	(*PartyRoomRequestMessage)(nil),      // 55: packets.PartyRoomRequestMessage
+	(*PlayerProfileRequestMessage)(nil),  // 56: packets.PlayerProfileRequestMessage
+	(*RatingChangeMessage)(nil),          // 57: packets.RatingChangeMessage
+	(*PlayerProfileMessage)(nil),         // 58: packets.PlayerProfileMessage
+	(*LeaderboardRequestMessage)(nil),    // 59: packets.LeaderboardRequestMessage
+	(*LeaderboardEntryMessage)(nil),      // 60: packets.LeaderboardEntryMessage
+	(*LeaderboardMessage)(nil),           // 61: packets.LeaderboardMessage
+	(*Packet)(nil),                       // 62: packets.Packet
 }
 var file_packets_proto_depIdxs = []int32{
-	9,  // 0: packets.SporesBatchMessage.spores:type_name -> packets.SporeMessage
-	0,  // 1: packets.Packet.chat:type_name -> packets.ChatMessage
-	1,  // 2: packets.Packet.id:type_name -> packets.IdMessage
-	2,  // 3: packets.Packet.login_request:type_name -> packets.LoginRequestMessage
-	3,  // 4: packets.Packet.guest_login_request:type_name -> packets.GuestLoginRequestMessage
-	4,  // 5: packets.Packet.register_request:type_name -> packets.RegisterRequestMessage
-	5,  // 6: packets.Packet.ok_response:type_name -> packets.OkResponseMessage
-	6,  // 7: packets.Packet.deny_response:type_name -> packets.DenyResponseMessage
-	7,  // 8: packets.Packet.player:type_name -> packets.PlayerMessage
-	8,  // 9: packets.Packet.player_direction:type_name -> packets.PlayerDirectionMessage
-	9,  // 10: packets.Packet.spore:type_name -> packets.SporeMessage
-	10, // 11: packets.Packet.spore_consumed:type_name -> packets.SporeConsumedMessage
-	11, // 12: packets.Packet.spores_batch:type_name -> packets.SporesBatchMessage
-	12, // 13: packets.Packet.player_consumed:type_name -> packets.PlayerConsumedMessage
-	13, // 14: packets.Packet.disconnect:type_name -> packets.DisconnectMessage
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	1,  // 0: packets.ChatMessage.target:type_name -> packets.ChatTarget
+	13, // 1: packets.SporesBatchMessage.spores:type_name -> packets.SporeMessage
+	18, // 2: packets.TeamScoreboardMessage.scores:type_name -> packets.TeamScoreMessage
+	0,  // 3: packets.RoundStateMessage.phase:type_name -> packets.RoundPhase
+	2,  // 4: packets.PowerUpMessage.kind:type_name -> packets.PowerUpKind
+	2,  // 5: packets.ActiveEffectMessage.kind:type_name -> packets.PowerUpKind
+	25, // 6: packets.PlayerEffectsMessage.effects:type_name -> packets.ActiveEffectMessage
+	30, // 7: packets.ChatHistoryMessage.messages:type_name -> packets.ChatHistoryEntryMessage
+	38, // 8: packets.HistoryMessage.sessions:type_name -> packets.SessionMessage
+	3,  // 9: packets.FriendMessage.state:type_name -> packets.FriendshipState
+	44, // 10: packets.FriendListMessage.friends:type_name -> packets.FriendMessage
+	47, // 11: packets.PartyMessage.members:type_name -> packets.PartyMemberMessage
+	57, // 12: packets.PlayerProfileMessage.history:type_name -> packets.RatingChangeMessage
+	60, // 13: packets.LeaderboardMessage.entries:type_name -> packets.LeaderboardEntryMessage
+	4,  // 14: packets.Packet.chat:type_name -> packets.ChatMessage
+	5,  // 15: packets.Packet.id:type_name -> packets.IdMessage
+	6,  // 16: packets.Packet.login_request:type_name -> packets.LoginRequestMessage
+	7,  // 17: packets.Packet.guest_login_request:type_name -> packets.GuestLoginRequestMessage
+	8,  // 18: packets.Packet.register_request:type_name -> packets.RegisterRequestMessage
+	9,  // 19: packets.Packet.ok_response:type_name -> packets.OkResponseMessage
+	10, // 20: packets.Packet.deny_response:type_name -> packets.DenyResponseMessage
+	11, // 21: packets.Packet.player:type_name -> packets.PlayerMessage
+	12, // 22: packets.Packet.player_direction:type_name -> packets.PlayerDirectionMessage
+	13, // 23: packets.Packet.spore:type_name -> packets.SporeMessage
+	14, // 24: packets.Packet.spore_consumed:type_name -> packets.SporeConsumedMessage
+	15, // 25: packets.Packet.spores_batch:type_name -> packets.SporesBatchMessage
+	16, // 26: packets.Packet.player_consumed:type_name -> packets.PlayerConsumedMessage
+	17, // 27: packets.Packet.disconnect:type_name -> packets.DisconnectMessage
+	19, // 28: packets.Packet.team_scoreboard:type_name -> packets.TeamScoreboardMessage
+	20, // 29: packets.Packet.round_state:type_name -> packets.RoundStateMessage
+	21, // 30: packets.Packet.safe_zone:type_name -> packets.SafeZoneMessage
+	23, // 31: packets.Packet.power_up:type_name -> packets.PowerUpMessage
+	24, // 32: packets.Packet.power_up_consumed:type_name -> packets.PowerUpConsumedMessage
+	26, // 33: packets.Packet.player_effects:type_name -> packets.PlayerEffectsMessage
+	27, // 34: packets.Packet.death:type_name -> packets.DeathMessage
+	28, // 35: packets.Packet.respawn_request:type_name -> packets.RespawnRequestMessage
+	29, // 36: packets.Packet.report_message:type_name -> packets.ReportMessageMessage
+	31, // 37: packets.Packet.chat_history:type_name -> packets.ChatHistoryMessage
+	32, // 38: packets.Packet.change_password_request:type_name -> packets.ChangePasswordRequestMessage
+	33, // 39: packets.Packet.reset_password_request:type_name -> packets.ResetPasswordRequestMessage
+	34, // 40: packets.Packet.delete_account_request:type_name -> packets.DeleteAccountRequestMessage
+	35, // 41: packets.Packet.profile:type_name -> packets.ProfileMessage
+	36, // 42: packets.Packet.get_profile_request:type_name -> packets.GetProfileRequestMessage
+	37, // 43: packets.Packet.history_request:type_name -> packets.HistoryRequestMessage
+	39, // 44: packets.Packet.history:type_name -> packets.HistoryMessage
+	40, // 45: packets.Packet.achievement_unlocked:type_name -> packets.AchievementUnlockedMessage
+	41, // 46: packets.Packet.add_friend_request:type_name -> packets.AddFriendRequestMessage
+	42, // 47: packets.Packet.remove_friend_request:type_name -> packets.RemoveFriendRequestMessage
+	43, // 48: packets.Packet.friend_list_request:type_name -> packets.FriendListRequestMessage
+	45, // 49: packets.Packet.friend_list:type_name -> packets.FriendListMessage
+	44, // 50: packets.Packet.friend:type_name -> packets.FriendMessage
+	46, // 51: packets.Packet.join_friend_request:type_name -> packets.JoinFriendRequestMessage
+	48, // 52: packets.Packet.party:type_name -> packets.PartyMessage
+	49, // 53: packets.Packet.party_create_request:type_name -> packets.PartyCreateRequestMessage
+	50, // 54: packets.Packet.party_invite_request:type_name -> packets.PartyInviteRequestMessage
+	51, // 55: packets.Packet.party_invite:type_name -> packets.PartyInviteMessage
+	52, // 56: packets.Packet.party_accept_request:type_name -> packets.PartyAcceptRequestMessage
+	53, // 57: packets.Packet.party_leave_request:type_name -> packets.PartyLeaveRequestMessage
+	54, // 58: packets.Packet.party_kick_request:type_name -> packets.PartyKickRequestMessage
+	55, // 59: packets.Packet.party_room_request:type_name -> packets.PartyRoomRequestMessage
+	56, // 60: packets.Packet.player_profile_request:type_name -> packets.PlayerProfileRequestMessage
+	58, // 61: packets.Packet.player_profile:type_name -> packets.PlayerProfileMessage
+	59, // 62: packets.Packet.leaderboard_request:type_name -> packets.LeaderboardRequestMessage
+	61, // 63: packets.Packet.leaderboard:type_name -> packets.LeaderboardMessage
+	22, // 64: packets.Packet.world_reset:type_name -> packets.WorldResetMessage
+	65, // [65:65] is the sub-list for method output_type
+	65, // [65:65] is the sub-list for method input_type
+	65, // [65:65] is the sub-list for extension type_name
+	65, // [65:65] is the sub-list for extension extendee
+	0,  // [0:65] is the sub-list for field type_name
 }
 
 func init() { file_packets_proto_init() }
@@ -1208,7 +5047,7 @@ func file_packets_proto_init() {
 	if File_packets_proto != nil {
 		return
 	}
-	file_packets_proto_msgTypes[14].OneofWrappers = []any{
+	file_packets_proto_msgTypes[58].OneofWrappers = []any{
 		(*Packet_Chat)(nil),
 		(*Packet_Id)(nil),
 		(*Packet_LoginRequest)(nil),
@@ -1223,19 +5062,57 @@ func file_packets_proto_init() {
 		(*Packet_SporesBatch)(nil),
 		(*Packet_PlayerConsumed)(nil),
 		(*Packet_Disconnect)(nil),
+		(*Packet_TeamScoreboard)(nil),
+		(*Packet_RoundState)(nil),
+		(*Packet_SafeZone)(nil),
+		(*Packet_PowerUp)(nil),
+		(*Packet_PowerUpConsumed)(nil),
+		(*Packet_PlayerEffects)(nil),
+		(*Packet_Death)(nil),
+		(*Packet_RespawnRequest)(nil),
+		(*Packet_ReportMessage)(nil),
+		(*Packet_ChatHistory)(nil),
+		(*Packet_ChangePasswordRequest)(nil),
+		(*Packet_ResetPasswordRequest)(nil),
+		(*Packet_DeleteAccountRequest)(nil),
+		(*Packet_Profile)(nil),
+		(*Packet_GetProfileRequest)(nil),
+		(*Packet_HistoryRequest)(nil),
+		(*Packet_History)(nil),
+		(*Packet_AchievementUnlocked)(nil),
+		(*Packet_AddFriendRequest)(nil),
+		(*Packet_RemoveFriendRequest)(nil),
+		(*Packet_FriendListRequest)(nil),
+		(*Packet_FriendList)(nil),
+		(*Packet_Friend)(nil),
+		(*Packet_JoinFriendRequest)(nil),
+		(*Packet_Party)(nil),
+		(*Packet_PartyCreateRequest)(nil),
+		(*Packet_PartyInviteRequest)(nil),
+		(*Packet_PartyInvite)(nil),
+		(*Packet_PartyAcceptRequest)(nil),
+		(*Packet_PartyLeaveRequest)(nil),
+		(*Packet_PartyKickRequest)(nil),
+		(*Packet_PartyRoomRequest)(nil),
+		(*Packet_PlayerProfileRequest)(nil),
+		(*Packet_PlayerProfile)(nil),
+		(*Packet_LeaderboardRequest)(nil),
+		(*Packet_Leaderboard)(nil),
+		(*Packet_WorldReset)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_packets_proto_rawDesc), len(file_packets_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   15,
+			NumEnums:      4,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_packets_proto_goTypes,
 		DependencyIndexes: file_packets_proto_depIdxs,
+		EnumInfos:         file_packets_proto_enumTypes,
 		MessageInfos:      file_packets_proto_msgTypes,
 	}.Build()
 	File_packets_proto = out.File
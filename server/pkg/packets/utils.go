@@ -1,6 +1,9 @@
 package packets
 
-import "server/internal/server/objects"
+import (
+	"server/internal/server/objects"
+	"time"
+)
 
 type Msg = isPacket_Msg
 
@@ -12,6 +15,16 @@ func NewChat(msg string) Msg {
 	}
 }
 
+// A chat message from the server rather than from a player
+func NewSystemChat(msg string) Msg {
+	return &Packet_Chat{
+		Chat: &ChatMessage{
+			Msg:    msg,
+			System: true,
+		},
+	}
+}
+
 func NewId(id uint64) Msg {
 	return &Packet_Id{
 		Id: &IdMessage{
@@ -45,6 +58,8 @@ func NewPlayer(id uint64, player *objects.Player) Msg {
 			Direction: player.Direction,
 			Speed:     player.Speed,
 			Color:     player.Color,
+			Team:      player.Team,
+			SkinId:    player.SkinId,
 		},
 	}
 }
@@ -84,3 +99,181 @@ func newSporeMessage(spore_id uint64, spore *objects.Spore) *SporeMessage {
 		Radius: spore.Radius,
 	}
 }
+
+func NewTeamScoreboard(scores []*TeamScoreMessage) Msg {
+	return &Packet_TeamScoreboard{
+		TeamScoreboard: &TeamScoreboardMessage{
+			Scores: scores,
+		},
+	}
+}
+
+func NewPlayerConsumed(playerId uint64) Msg {
+	return &Packet_PlayerConsumed{
+		PlayerConsumed: &PlayerConsumedMessage{
+			PlayerId: playerId,
+		},
+	}
+}
+
+func NewRoundState(round uint32, phase RoundPhase, timeRemaining float64, winnerId uint64, winnerName string) Msg {
+	return &Packet_RoundState{
+		RoundState: &RoundStateMessage{
+			Round:         round,
+			Phase:         phase,
+			TimeRemaining: timeRemaining,
+			WinnerId:      winnerId,
+			WinnerName:    winnerName,
+		},
+	}
+}
+
+func NewSafeZone(x, y, radius, targetRadius float64) Msg {
+	return &Packet_SafeZone{
+		SafeZone: &SafeZoneMessage{
+			X:            x,
+			Y:            y,
+			Radius:       radius,
+			TargetRadius: targetRadius,
+		},
+	}
+}
+
+func NewWorldReset() Msg {
+	return &Packet_WorldReset{
+		WorldReset: &WorldResetMessage{},
+	}
+}
+
+func NewPowerUp(id uint64, powerUp *objects.PowerUp) Msg {
+	return &Packet_PowerUp{
+		PowerUp: &PowerUpMessage{
+			Id:     id,
+			X:      powerUp.X,
+			Y:      powerUp.Y,
+			Radius: powerUp.Radius,
+			Kind:   PowerUpKind(powerUp.Kind),
+		},
+	}
+}
+
+func NewPowerUpConsumed(powerUpId uint64) Msg {
+	return &Packet_PowerUpConsumed{
+		PowerUpConsumed: &PowerUpConsumedMessage{
+			PowerUpId: powerUpId,
+		},
+	}
+}
+
+func NewPlayerEffects(playerId uint64, effects map[objects.PowerUpKind]time.Duration) Msg {
+	activeEffects := make([]*ActiveEffectMessage, 0, len(effects))
+	for kind, remaining := range effects {
+		activeEffects = append(activeEffects, &ActiveEffectMessage{
+			Kind:          PowerUpKind(kind),
+			TimeRemaining: remaining.Seconds(),
+		})
+	}
+
+	return &Packet_PlayerEffects{
+		PlayerEffects: &PlayerEffectsMessage{
+			PlayerId: playerId,
+			Effects:  activeEffects,
+		},
+	}
+}
+
+func NewChatHistory(messages []*ChatHistoryEntryMessage) Msg {
+	return &Packet_ChatHistory{
+		ChatHistory: &ChatHistoryMessage{
+			Messages: messages,
+		},
+	}
+}
+
+func NewHistory(sessions []*SessionMessage) Msg {
+	return &Packet_History{
+		History: &HistoryMessage{
+			Sessions: sessions,
+		},
+	}
+}
+
+func NewAchievementUnlocked(id string, name string, description string) Msg {
+	return &Packet_AchievementUnlocked{
+		AchievementUnlocked: &AchievementUnlockedMessage{
+			Id:          id,
+			Name:        name,
+			Description: description,
+		},
+	}
+}
+
+func NewFriend(username string, state FriendshipState, online bool, room string, status string) Msg {
+	return &Packet_Friend{
+		Friend: &FriendMessage{
+			Username: username,
+			State:    state,
+			Online:   online,
+			Room:     room,
+			Status:   status,
+		},
+	}
+}
+
+func NewFriendList(friends []*FriendMessage) Msg {
+	return &Packet_FriendList{
+		FriendList: &FriendListMessage{
+			Friends: friends,
+		},
+	}
+}
+
+func NewParty(partyId uint64, leaderId uint64, members []*PartyMemberMessage, room string) Msg {
+	return &Packet_Party{
+		Party: &PartyMessage{
+			PartyId:  partyId,
+			LeaderId: leaderId,
+			Members:  members,
+			Room:     room,
+		},
+	}
+}
+
+func NewPartyInvite(partyId uint64, leaderName string) Msg {
+	return &Packet_PartyInvite{
+		PartyInvite: &PartyInviteMessage{
+			PartyId:    partyId,
+			LeaderName: leaderName,
+		},
+	}
+}
+
+func NewProfile(color int32, displayName string, skinId uint32, settings []byte) Msg {
+	return &Packet_Profile{
+		Profile: &ProfileMessage{
+			Color:       color,
+			DisplayName: displayName,
+			SkinId:      skinId,
+			Settings:    settings,
+		},
+	}
+}
+
+func NewPlayerProfile(username string, rating float64, rank uint32, history []*RatingChangeMessage) Msg {
+	return &Packet_PlayerProfile{
+		PlayerProfile: &PlayerProfileMessage{
+			Username: username,
+			Rating:   rating,
+			Rank:     rank,
+			History:  history,
+		},
+	}
+}
+
+func NewLeaderboard(entries []*LeaderboardEntryMessage) Msg {
+	return &Packet_Leaderboard{
+		Leaderboard: &LeaderboardMessage{
+			Entries: entries,
+		},
+	}
+}